@@ -0,0 +1,7 @@
+data "wandb_artifact_version" "production" {
+  artifact = "<entity-name>/<project-name>/<artifact-name>:production"
+}
+
+output "production_digest" {
+  value = data.wandb_artifact_version.production.digest
+}
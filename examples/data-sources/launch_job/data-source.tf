@@ -0,0 +1,26 @@
+data "wandb_launch_job" "evaluate" {
+  job = "<entity-name>/<project-name>/<job-name>:latest"
+}
+
+resource "wandb_automation" "evaluate_on_promotion" {
+  name = "evaluate-on-promotion"
+
+  event = {
+    type        = "artifact_alias_added"
+    alias_regex = "production"
+  }
+
+  scope = {
+    type        = "project"
+    entity_name = "<entity-name>"
+    name        = "<project-name>"
+  }
+
+  action = {
+    type     = "queue_job"
+    queue_id = "<entity-name>:<queue-name>"
+    template = jsonencode({
+      job = data.wandb_launch_job.evaluate.versioned_job
+    })
+  }
+}
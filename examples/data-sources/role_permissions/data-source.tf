@@ -0,0 +1,11 @@
+data "wandb_role_permissions" "launch" {
+  organization_name = "<organization-name>"
+  group             = "launch"
+}
+
+resource "wandb_custom_role" "launcher" {
+  organization_name = "<organization-name>"
+  name              = "launcher"
+  base_role         = "viewer"
+  permissions       = data.wandb_role_permissions.launch.permissions[*].name
+}
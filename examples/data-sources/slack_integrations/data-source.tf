@@ -0,0 +1,8 @@
+data "wandb_slack_integrations" "alerts" {
+  entity_name  = "<entity-name>"
+  channel_name = "ml-alerts"
+}
+
+output "alerts_integration_id" {
+  value = data.wandb_slack_integrations.alerts.integrations[0].id
+}
@@ -0,0 +1,3 @@
+data "wandb_user" "example" {
+  email = "jane.doe@example.com"
+}
@@ -9,3 +9,13 @@ terraform {
 provider "wandb" {
   base_url = "https://api.wandb.ai"
 }
+
+# A second W&B instance managed from the same configuration. Resources that
+# omit entity_name use the provider's default_entity.
+provider "wandb" {
+  alias           = "dedicated"
+  base_url        = "https://<dedicated-instance-url>"
+  api_key_file    = "<path-to-api-key-file>"
+  default_entity  = "<entity-name>"
+  request_timeout = "2m"
+}
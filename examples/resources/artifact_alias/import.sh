@@ -0,0 +1,2 @@
+# Artifact aliases can be imported by specifying the artifact version and the alias separated by a `:`
+terraform import wandb_artifact_alias.example <entity-name>/<project-name>/<artifact-name>:<version>:<alias>
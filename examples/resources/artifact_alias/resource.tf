@@ -0,0 +1,4 @@
+resource "wandb_artifact_alias" "production" {
+  artifact = "<entity-name>/<project-name>/<artifact-name>:v3"
+  alias    = "production"
+}
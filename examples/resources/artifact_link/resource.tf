@@ -0,0 +1,20 @@
+resource "wandb_registry" "models" {
+  name           = "production-models"
+  entity_name    = "<organization-entity-name>"
+  artifact_types = ["model"]
+}
+
+resource "wandb_registry_collection" "classifier" {
+  name        = "sentiment-classifier"
+  entity_name = wandb_registry.models.entity_name
+  registry    = wandb_registry.models.name
+  type        = "model"
+}
+
+resource "wandb_artifact_link" "tf_example" {
+  artifact    = "<entity-name>/<project-name>/<artifact-name>:v3"
+  entity_name = wandb_registry_collection.classifier.entity_name
+  registry    = wandb_registry_collection.classifier.registry
+  collection  = wandb_registry_collection.classifier.name
+  aliases     = ["production"]
+}
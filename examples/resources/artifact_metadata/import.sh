@@ -0,0 +1,2 @@
+# Artifact metadata can be imported by specifying the artifact version
+terraform import wandb_artifact_metadata.example <entity-name>/<project-name>/<artifact-name>:<version>
@@ -0,0 +1,11 @@
+resource "wandb_artifact_metadata" "tf_example" {
+  artifact    = "<entity-name>/<project-name>/<artifact-name>:v3"
+  description = "Candidate model trained on the March dataset"
+
+  metadata = jsonencode({
+    accuracy = 0.92
+    dataset  = "march"
+  })
+
+  tags = ["candidate", "march"]
+}
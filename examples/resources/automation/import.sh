@@ -0,0 +1,3 @@
+# Automations can be imported by specifying the entity, project and automation ID separated by a `:`
+# For automations scoped to a registry, the project is the registry name prefixed with `wandb-registry-`.
+terraform import wandb_automation.example <entity-name>:<project-name>:<automation-id>
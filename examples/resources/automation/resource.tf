@@ -0,0 +1,57 @@
+resource "wandb_run_queue" "evaluation" {
+  name        = "evaluation-queue"
+  entity_name = "<entity-name>"
+  resource    = "kubernetes"
+}
+
+resource "wandb_automation" "evaluate_on_promotion" {
+  name        = "evaluate-on-promotion"
+  description = "Launch an evaluation job when a model version is promoted to production."
+
+  event = {
+    type        = "artifact_alias_added"
+    alias_regex = "production"
+  }
+
+  scope = {
+    type        = "registry"
+    entity_name = "<organization-entity-name>"
+    name        = "model"
+  }
+
+  action = {
+    type     = "queue_job"
+    queue_id = wandb_run_queue.evaluation.id
+    template = jsonencode({
+      job = "<entity-name>/<project-name>/<job-name>:latest"
+    })
+  }
+}
+
+resource "wandb_automation" "loss_alert" {
+  name = "loss-alert"
+
+  event = {
+    type = "run_metric_threshold"
+    metric = {
+      name        = "loss"
+      operator    = ">"
+      threshold   = 10
+      window_size = 5
+    }
+  }
+
+  scope = {
+    type        = "project"
+    entity_name = "<entity-name>"
+    name        = "<project-name>"
+  }
+
+  action = {
+    type           = "notification"
+    integration_id = "<slack-integration-id>"
+    title          = "Loss diverged"
+    message        = "A run's loss exceeded the threshold."
+    severity       = "WARN"
+  }
+}
@@ -0,0 +1,2 @@
+# Custom roles can be imported by specifying the organization name and role ID separated by a `:`
+terraform import wandb_custom_role.example <organization-name>:<role-id>
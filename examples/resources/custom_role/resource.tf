@@ -0,0 +1,7 @@
+resource "wandb_custom_role" "tf_example" {
+  organization_name = "<organization-name>"
+  name              = "launcher"
+  description       = "Viewer that can launch runs"
+  base_role         = "viewer"
+  permissions       = ["launch.queue.push"]
+}
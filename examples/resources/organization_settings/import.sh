@@ -0,0 +1,2 @@
+# Organization settings can be imported by specifying the organization name
+terraform import wandb_organization_settings.example <organization-name>
@@ -0,0 +1,7 @@
+resource "wandb_organization_settings" "tf_example" {
+  organization_name     = "<organization-name>"
+  default_team_privacy  = "private"
+  allowed_email_domains = ["example.com"]
+  code_saving_enabled   = false
+  hide_public_projects  = true
+}
@@ -0,0 +1,2 @@
+# Registries can be imported by specifying the entity and registry name separated by a `:`
+terraform import wandb_registry.example <entity-name>:<registry-name>
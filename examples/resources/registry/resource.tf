@@ -0,0 +1,19 @@
+data "wandb_user" "ml_lead" {
+  email = "ml-lead@example.com"
+}
+
+resource "wandb_registry" "tf_example" {
+  name        = "production-models"
+  entity_name = "<organization-entity-name>"
+  description = "Models promoted to production"
+  visibility  = "restricted"
+
+  artifact_types = ["model"]
+
+  members = [
+    {
+      user_id = data.wandb_user.ml_lead.id
+      role    = "admin"
+    },
+  ]
+}
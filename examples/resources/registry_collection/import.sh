@@ -0,0 +1,2 @@
+# Registry collections can be imported by specifying the entity, registry and collection name separated by a `:`
+terraform import wandb_registry_collection.example <entity-name>:<registry-name>:<collection-name>
@@ -0,0 +1,14 @@
+resource "wandb_registry" "models" {
+  name           = "production-models"
+  entity_name    = "<organization-entity-name>"
+  artifact_types = ["model"]
+}
+
+resource "wandb_registry_collection" "tf_example" {
+  name        = "sentiment-classifier"
+  entity_name = wandb_registry.models.entity_name
+  registry    = wandb_registry.models.name
+  type        = "model"
+  description = "Sentiment classifier promoted by the training pipeline"
+  tags        = ["nlp"]
+}
@@ -31,10 +31,24 @@ resource "wandb_run_queue" "tf_example" {
     }
   })
 
-  prioritization_mode = "V0"
+  prioritization_mode          = "V0"
+  default_priority             = "medium"
+  max_concurrent_runs          = 10
+  max_concurrent_runs_per_user = 2
+
+  access = {
+    teams             = ["<team-name>"]
+    service_accounts  = ["<service-account-username>"]
+    admin_only_config = true
+  }
+
   external_links = {
     "label" : "https://example.com",
     "label2" : "https://example2.com"
   }
 
+  timeouts {
+    create = "5m"
+    delete = "5m"
+  }
 }
\ No newline at end of file
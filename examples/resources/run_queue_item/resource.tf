@@ -0,0 +1,58 @@
+resource "wandb_run_queue" "training" {
+  name        = "training-queue"
+  entity_name = "<entity-name>"
+  resource    = "kubernetes"
+
+  resource_config = jsonencode({
+    apiVersion = "batch/v1",
+    kind       = "Job",
+    spec = {
+      template = {
+        spec = {
+          containers = [{
+            name = "trainer",
+            resources = {
+              limits = {
+                "nvidia.com/gpu" = "{{gpus}}"
+              }
+            }
+          }],
+          restartPolicy = "Never"
+        }
+      }
+    }
+  })
+
+  template_variables = jsonencode({
+    gpus = {
+      description = "The number of GPUs to request",
+      schema = {
+        type    = "integer",
+        minimum = 1,
+        maximum = 8
+      }
+    }
+  })
+
+  prioritization_mode = "V0"
+}
+
+data "wandb_launch_job" "train" {
+  job = "<entity-name>/<project-name>/<job-name>:latest"
+}
+
+resource "wandb_run_queue_item" "retrain" {
+  queue_id = wandb_run_queue.training.id
+  job      = data.wandb_launch_job.train.versioned_job
+  priority = "high"
+
+  template_variable_values = {
+    gpus = "4"
+  }
+
+  overrides = jsonencode({
+    run_config = {
+      epochs = 10
+    }
+  })
+}
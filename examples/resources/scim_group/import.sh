@@ -0,0 +1,2 @@
+# SCIM groups can be imported by specifying the SCIM ID of the group
+terraform import wandb_scim_group.example <scim-group-id>
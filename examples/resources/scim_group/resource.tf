@@ -0,0 +1,9 @@
+resource "wandb_scim_user" "tf_example" {
+  user_name = "<username>"
+  email     = "<email>"
+}
+
+resource "wandb_scim_group" "tf_example" {
+  display_name = "<team-name>"
+  members      = [wandb_scim_user.tf_example.id]
+}
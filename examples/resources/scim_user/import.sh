@@ -0,0 +1,2 @@
+# SCIM users can be imported by specifying the SCIM ID of the user
+terraform import wandb_scim_user.example <scim-user-id>
@@ -0,0 +1,5 @@
+resource "wandb_scim_user" "tf_example" {
+  user_name    = "<username>"
+  email        = "<email>"
+  display_name = "<display-name>"
+}
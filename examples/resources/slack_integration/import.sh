@@ -0,0 +1,3 @@
+# Slack integrations can be imported by specifying the entity and integration ID separated by a `:`
+# Integrations created in the W&B UI can be found with the wandb_slack_integrations data source.
+terraform import wandb_slack_integration.example <entity-name>:<integration-id>
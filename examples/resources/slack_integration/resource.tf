@@ -0,0 +1,36 @@
+variable "slack_oauth_code" {
+  type      = string
+  sensitive = true
+}
+
+resource "wandb_slack_integration" "alerts" {
+  entity_name  = "<entity-name>"
+  code         = var.slack_oauth_code
+  redirect_uri = "https://wandb.ai/<entity-name>/settings"
+}
+
+resource "wandb_automation" "loss_alert" {
+  name = "loss-alert"
+
+  event = {
+    type = "run_metric_threshold"
+    metric = {
+      name      = "loss"
+      operator  = ">"
+      threshold = 10
+    }
+  }
+
+  scope = {
+    type        = "project"
+    entity_name = "<entity-name>"
+    name        = "<project-name>"
+  }
+
+  action = {
+    type           = "notification"
+    integration_id = wandb_slack_integration.alerts.integration_id
+    title          = "Loss diverged"
+    message        = "A run's loss exceeded the threshold."
+  }
+}
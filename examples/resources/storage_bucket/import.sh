@@ -0,0 +1,2 @@
+# Storage buckets can be imported by specifying the team name
+terraform import wandb_storage_bucket.example <team-name>
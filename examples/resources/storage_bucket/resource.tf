@@ -0,0 +1,8 @@
+resource "wandb_storage_bucket" "team" {
+  entity_name   = "<team-name>"
+  provider_type = "s3"
+  bucket        = "<bucket-name>"
+  path_prefix   = "wandb"
+  kms_key_id    = "arn:aws:kms:us-east-1:<account-id>:key/<key-id>"
+  region        = "us-east-1"
+}
@@ -0,0 +1,3 @@
+# Sweeps can be imported by specifying the entity, project and sweep ID separated by a `:`
+# Imported sweeps are read into config_yaml.
+terraform import wandb_sweep.example <entity-name>:<project-name>:<sweep-id>
@@ -0,0 +1,55 @@
+resource "wandb_sweep" "typed" {
+  entity_name  = "<entity-name>"
+  project_name = "<project-name>"
+  description  = "Learning rate and optimizer search"
+  method       = "bayes"
+  program      = "train.py"
+
+  metric = {
+    name = "val_loss"
+    goal = "minimize"
+  }
+
+  parameters = {
+    learning_rate = {
+      distribution = "log_uniform_values"
+      min          = 0.0001
+      max          = 0.1
+    }
+    optimizer = {
+      values = ["adam", "sgd"]
+    }
+    batch_size = {
+      values = ["32", "64", "128"]
+    }
+  }
+
+  early_terminate = {
+    type     = "hyperband"
+    min_iter = 3
+  }
+}
+
+resource "wandb_run_queue" "sweeps" {
+  name        = "sweep-queue"
+  entity_name = "<entity-name>"
+  resource    = "kubernetes"
+}
+
+resource "wandb_sweep" "launch" {
+  entity_name  = "<entity-name>"
+  project_name = "<project-name>"
+  queue_id     = wandb_run_queue.sweeps.id
+  state        = "paused"
+
+  config_yaml = <<-EOT
+    method: grid
+    job: <entity-name>/<project-name>/<job-name>:latest
+    metric:
+      name: accuracy
+      goal: maximize
+    parameters:
+      dropout:
+        values: [0.1, 0.2, 0.3]
+  EOT
+}
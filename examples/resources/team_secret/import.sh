@@ -0,0 +1,3 @@
+# Team secrets can be imported by specifying the entity and secret name separated by a `:`
+# The secret value is never returned by the API, so it must be set in configuration after import.
+terraform import wandb_team_secret.example <entity-name>:<secret-name>
@@ -0,0 +1,10 @@
+variable "registry_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "wandb_team_secret" "tf_example" {
+  name        = "REGISTRY_PASSWORD"
+  entity_name = "<entity-name>"
+  value       = var.registry_password
+}
@@ -0,0 +1,2 @@
+# Users can be imported by specifying the user ID
+terraform import wandb_user.example <user-id>
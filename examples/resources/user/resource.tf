@@ -0,0 +1,4 @@
+resource "wandb_user" "example" {
+  email = "jane.doe@example.com"
+  admin = false
+}
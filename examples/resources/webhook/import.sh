@@ -0,0 +1,2 @@
+# Webhooks can be imported by specifying the entity and integration ID separated by a `:`
+terraform import wandb_webhook.example <entity-name>:<integration-id>
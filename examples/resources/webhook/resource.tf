@@ -0,0 +1,41 @@
+variable "deploy_webhook_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "wandb_team_secret" "webhook_token" {
+  name        = "DEPLOY_WEBHOOK_TOKEN"
+  entity_name = "<entity-name>"
+  value       = var.deploy_webhook_token
+}
+
+resource "wandb_webhook" "deploy" {
+  name             = "deploy-model"
+  entity_name      = "<entity-name>"
+  url              = "https://deploy.example.com/hooks/wandb"
+  access_token_ref = wandb_team_secret.webhook_token.name
+}
+
+resource "wandb_automation" "deploy_on_promotion" {
+  name = "deploy-on-promotion"
+
+  event = {
+    type        = "artifact_alias_added"
+    alias_regex = "production"
+  }
+
+  scope = {
+    type        = "project"
+    entity_name = "<entity-name>"
+    name        = "<project-name>"
+  }
+
+  action = {
+    type           = "webhook"
+    integration_id = wandb_webhook.deploy.integration_id
+    payload = jsonencode({
+      event_type = "$${event_type}"
+      artifact   = "$${artifact_version_string}"
+    })
+  }
+}
@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/machinebox/graphql"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ArtifactAliasResource{}
+var _ resource.ResourceWithConfigure = &ArtifactAliasResource{}
+var _ resource.ResourceWithImportState = &ArtifactAliasResource{}
+
+func NewArtifactAliasResource() resource.Resource {
+	return &ArtifactAliasResource{}
+}
+
+type ArtifactAliasResource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type ArtifactAliasResourceModel struct {
+	Id         types.String   `tfsdk:"id"`
+	Artifact   types.String   `tfsdk:"artifact"`
+	Alias      types.String   `tfsdk:"alias"`
+	ArtifactId types.String   `tfsdk:"artifact_id"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ArtifactAliasResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "wandb_artifact_alias"
+}
+
+func (r *ArtifactAliasResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an alias on an existing artifact version, for example promoting a model version to `production`. Adding an alias that already points to another version of the same artifact moves it to this version. See [here](https://github.com/wandb/terraform-provider-wandb/blob/main/examples/resources/artifact_alias/resource.tf) for an example",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the alias. This is a composite ID of the artifact path and the alias, separated by a ':'",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"artifact": schema.StringAttribute{
+				Required:    true,
+				Description: "The artifact version to alias, in the form entity/project/artifact:version.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"alias": schema.StringAttribute{
+				Required:    true,
+				Description: "The alias to add to the artifact version, for example 'production' or 'staging'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"artifact_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the artifact version the alias points to.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ArtifactAliasResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ArtifactAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ArtifactAliasResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	artifactPath, err := parseArtifactPath(data.Artifact.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing artifact path", err.Error())
+		return
+	}
+
+	artifact, err := readArtifactHelper(ctx, artifactPath, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading artifact",
+			"Could not read artifact "+artifactPath.String()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	success, err := updateArtifactAliases(ctx, "addAliases", artifact.ID, artifactPath, data.Alias.ValueString(), r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating artifact alias",
+			"Could not create artifact alias, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !success {
+		resp.Diagnostics.AddError(
+			"Failed to create artifact alias",
+			"The API did not confirm the creation of the artifact alias.",
+		)
+		return
+	}
+
+	data.Id = types.StringValue(generateCompositeID(data.Artifact.ValueString(), data.Alias.ValueString()))
+	data.ArtifactId = types.StringValue(artifact.ID)
+
+	tflog.Trace(ctx, "created an artifact alias resource")
+
+	resp.Diagnostics.Append(r.client.recordInstance(ctx, resp.Private)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactAliasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ArtifactAliasResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkInstance(ctx, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	artifactRef, alias, err := parseArtifactAliasID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing composite ID", err.Error())
+		return
+	}
+	artifactPath, err := parseArtifactPath(artifactRef)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing artifact path", err.Error())
+		return
+	}
+
+	artifact, err := readArtifactHelper(ctx, artifactPath, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading artifact",
+			"Could not read artifact "+artifactPath.String()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// The alias was removed or moved to another version outside of Terraform.
+	if !artifactHasAlias(artifact, artifactPath.Name, alias) {
+		tflog.Warn(ctx, "artifact alias no longer points to the configured version, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Artifact = types.StringValue(artifactRef)
+	data.Alias = types.StringValue(alias)
+	data.ArtifactId = types.StringValue(artifact.ID)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactAliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement, so there is nothing to update in place.
+	var data ArtifactAliasResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactAliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ArtifactAliasResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	artifactPath, err := parseArtifactPath(data.Artifact.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing artifact path", err.Error())
+		return
+	}
+
+	success, err := updateArtifactAliases(ctx, "deleteAliases", data.ArtifactId.ValueString(), artifactPath, data.Alias.ValueString(), r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting artifact alias",
+			"Could not delete artifact alias, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !success {
+		resp.Diagnostics.AddError(
+			"Failed to delete artifact alias",
+			"The API did not confirm the deletion of the artifact alias.",
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an artifact alias resource")
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ArtifactAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateArtifactAliases runs either the addAliases or deleteAliases mutation, which share an input shape.
+func updateArtifactAliases(ctx context.Context, mutation, artifactID string, artifactPath ArtifactPath, alias string, client *GraphQLClientWithHeaders) (bool, error) {
+	gqlReq := graphql.NewRequest(fmt.Sprintf(`
+		mutation UpdateAliases($artifactID: ID!, $aliases: [ArtifactCollectionAliasInput!]!) {
+			%s(input: {artifactID: $artifactID, aliases: $aliases}) {
+				success
+			}
+		}
+	`, mutation))
+	gqlReq.Var("artifactID", artifactID)
+	gqlReq.Var("aliases", []map[string]string{
+		{
+			"artifactCollectionName": artifactPath.Name,
+			"entityName":             artifactPath.EntityName,
+			"projectName":            artifactPath.ProjectName,
+			"alias":                  alias,
+		},
+	})
+
+	var result map[string]struct {
+		Success bool `json:"success"`
+	}
+
+	if err := client.Run(ctx, gqlReq, &result); err != nil {
+		return false, err
+	}
+	return result[mutation].Success, nil
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccArtifactAliasResource(t *testing.T) {
+	resourceName := "wandb_artifact_alias.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckArtifactAliasResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArtifactAliasResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "terraform-acceptance-test/artifacts/example-model:v0:terraform-staging"),
+					resource.TestCheckResourceAttr(resourceName, "artifact", "terraform-acceptance-test/artifacts/example-model:v0"),
+					resource.TestCheckResourceAttr(resourceName, "alias", "terraform-staging"),
+					resource.TestCheckResourceAttrSet(resourceName, "artifact_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckArtifactAliasResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "wandb_artifact_alias" {
+			continue
+		}
+
+		artifactPath, err := parseArtifactPath(rs.Primary.Attributes["artifact"])
+		if err != nil {
+			return err
+		}
+
+		artifact, err := readArtifactHelper(context.Background(), artifactPath, newGraphQLClient())
+		if err != nil {
+			return err
+		}
+
+		if artifactHasAlias(artifact, artifactPath.Name, rs.Primary.Attributes["alias"]) {
+			return fmt.Errorf("artifact alias still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccArtifactAliasResourceConfig() string {
+	return `
+resource "wandb_artifact_alias" "test" {
+  artifact = "terraform-acceptance-test/artifacts/example-model:v0"
+  alias    = "terraform-staging"
+}
+`
+}
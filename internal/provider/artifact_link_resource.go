@@ -0,0 +1,449 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/machinebox/graphql"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ArtifactLinkResource{}
+var _ resource.ResourceWithConfigure = &ArtifactLinkResource{}
+var _ resource.ResourceWithModifyPlan = &ArtifactLinkResource{}
+
+func NewArtifactLinkResource() resource.Resource {
+	return &ArtifactLinkResource{}
+}
+
+type ArtifactLinkResource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type ArtifactLinkResourceModel struct {
+	Id           types.String   `tfsdk:"id"`
+	Artifact     types.String   `tfsdk:"artifact"`
+	EntityName   types.String   `tfsdk:"entity_name"`
+	Registry     types.String   `tfsdk:"registry"`
+	Collection   types.String   `tfsdk:"collection"`
+	Aliases      types.Set      `tfsdk:"aliases"`
+	ArtifactId   types.String   `tfsdk:"artifact_id"`
+	VersionIndex types.Int64    `tfsdk:"version_index"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ArtifactLinkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "wandb_artifact_link"
+}
+
+func (r *ArtifactLinkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Links an artifact version into a registry collection, which is how a model is promoted into a registry. Destroying the resource unlinks the version. See [here](https://github.com/wandb/terraform-provider-wandb/blob/main/examples/resources/artifact_link/resource.tf) for an example",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the link. This is the path of the linked version, in the form entity/project/collection:version.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"artifact": schema.StringAttribute{
+				Required:    true,
+				Description: "The source artifact version to link, in the form entity/project/artifact:version.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the organization's entity that the registry belongs to. Defaults to the provider's default_entity.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"registry": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the registry, without the 'wandb-registry-' prefix.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"collection": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the registry collection to link the artifact version into.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"aliases": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Aliases to add to the linked version within the collection, for example 'production'.",
+			},
+			"artifact_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the linked artifact version.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version_index": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The version index of the linked version within the collection.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ArtifactLinkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(client.requireServerSupport(ctx, "wandb_artifact_link", "Project", "allowAllArtifactTypesInRegistry")...)
+	r.client = client
+}
+
+func (r *ArtifactLinkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		return
+	}
+
+	r.client.applyProviderDefaults(ctx, req, resp, "entity_name")
+}
+
+func (r *ArtifactLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ArtifactLinkResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	artifactPath, err := parseArtifactPath(data.Artifact.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing artifact path", err.Error())
+		return
+	}
+
+	artifact, err := readArtifactHelper(ctx, artifactPath, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading artifact",
+			"Could not read artifact "+artifactPath.String()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var aliases []string
+	resp.Diagnostics.Append(data.Aliases.ElementsAs(ctx, &aliases, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aliasInputs := make([]map[string]string, 0, len(aliases))
+	for _, alias := range aliases {
+		aliasInputs = append(aliasInputs, map[string]string{
+			"artifactCollectionName": data.Collection.ValueString(),
+			"alias":                  alias,
+		})
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation LinkArtifact(
+			$artifactID: ID!,
+			$artifactPortfolioName: String!,
+			$entityName: String!,
+			$projectName: String!,
+			$aliases: [ArtifactAliasInput!],
+		) {
+			linkArtifact(input: {
+				artifactID: $artifactID,
+				artifactPortfolioName: $artifactPortfolioName,
+				entityName: $entityName,
+				projectName: $projectName,
+				aliases: $aliases,
+			}) {
+				versionIndex
+			}
+		}
+	`)
+	gqlReq.Var("artifactID", artifact.ID)
+	gqlReq.Var("artifactPortfolioName", data.Collection.ValueString())
+	gqlReq.Var("entityName", data.EntityName.ValueString())
+	gqlReq.Var("projectName", registryProjectPrefix+data.Registry.ValueString())
+	gqlReq.Var("aliases", aliasInputs)
+
+	var result struct {
+		LinkArtifact struct {
+			VersionIndex *int `json:"versionIndex"`
+		} `json:"linkArtifact"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error linking artifact",
+			"Could not link artifact, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if result.LinkArtifact.VersionIndex == nil {
+		resp.Diagnostics.AddError(
+			"Failed to link artifact",
+			"The API did not return the version index of the linked artifact.",
+		)
+		return
+	}
+
+	data.Id = types.StringValue(r.linkedArtifactPath(&data, *result.LinkArtifact.VersionIndex).String())
+	data.ArtifactId = types.StringValue(artifact.ID)
+	data.VersionIndex = types.Int64Value(int64(*result.LinkArtifact.VersionIndex))
+
+	tflog.Trace(ctx, "created an artifact link resource")
+
+	resp.Diagnostics.Append(r.client.recordInstance(ctx, resp.Private)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ArtifactLinkResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkInstance(ctx, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	linkedPath, err := parseArtifactPath(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing artifact path", err.Error())
+		return
+	}
+
+	linked, err := readArtifactHelper(ctx, linkedPath, r.client)
+	if err != nil && err.Error() != "artifact not found" {
+		resp.Diagnostics.AddError(
+			"Error reading linked artifact",
+			"Could not read linked artifact "+linkedPath.String()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// The version was unlinked outside of Terraform.
+	if linked == nil || linked.ID != data.ArtifactId.ValueString() {
+		tflog.Warn(ctx, "artifact version is no longer linked into the collection, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if !data.Aliases.IsNull() {
+		aliases := make([]attr.Value, 0, len(linked.Aliases))
+		for _, alias := range linked.Aliases {
+			if alias.ArtifactCollectionName != linkedPath.Name || alias.Alias == "latest" || alias.Alias == linkedPath.Version {
+				continue
+			}
+			aliases = append(aliases, types.StringValue(alias.Alias))
+		}
+		aliasSet, diags := types.SetValue(types.StringType, aliases)
+		resp.Diagnostics.Append(diags...)
+		data.Aliases = aliasSet
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ArtifactLinkResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the aliases can change without replacing the link.
+	var current, desired []string
+	resp.Diagnostics.Append(state.Aliases.ElementsAs(ctx, &current, false)...)
+	resp.Diagnostics.Append(data.Aliases.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	linkedPath := r.linkedArtifactPath(&state, int(state.VersionIndex.ValueInt64()))
+	added, removed := diffStringSets(current, desired)
+	for _, change := range []struct {
+		mutation string
+		aliases  []string
+	}{
+		{"deleteAliases", removed},
+		{"addAliases", added},
+	} {
+		for _, alias := range change.aliases {
+			success, err := updateArtifactAliases(ctx, change.mutation, state.ArtifactId.ValueString(), linkedPath, alias, r.client)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error updating artifact link aliases",
+					"Could not update alias "+alias+", unexpected error: "+err.Error(),
+				)
+				return
+			}
+			if !success {
+				resp.Diagnostics.AddError(
+					"Failed to update artifact link aliases",
+					"The API did not confirm the update of alias "+alias+".",
+				)
+				return
+			}
+		}
+	}
+
+	data.Id = state.Id
+	data.ArtifactId = state.ArtifactId
+	data.VersionIndex = state.VersionIndex
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ArtifactLinkResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collection, err := readRegistryCollectionHelper(ctx, data.EntityName.ValueString(), data.Registry.ValueString(), data.Collection.ValueString(), r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading registry collection for unlink",
+			"Could not read registry collection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation UnlinkArtifact($artifactID: ID!, $artifactPortfolioID: ID!) {
+			unlinkArtifact(input: {artifactID: $artifactID, artifactPortfolioID: $artifactPortfolioID}) {
+				success
+			}
+		}
+	`)
+	gqlReq.Var("artifactID", data.ArtifactId.ValueString())
+	gqlReq.Var("artifactPortfolioID", collection.ID)
+
+	var result struct {
+		UnlinkArtifact struct {
+			Success bool `json:"success"`
+		} `json:"unlinkArtifact"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error unlinking artifact",
+			"Could not unlink artifact, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !result.UnlinkArtifact.Success {
+		resp.Diagnostics.AddError(
+			"Failed to unlink artifact",
+			"The API did not confirm the unlinking of the artifact.",
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an artifact link resource")
+
+	resp.State.RemoveResource(ctx)
+}
+
+// linkedArtifactPath returns the path of the linked version within the registry collection.
+func (r *ArtifactLinkResource) linkedArtifactPath(data *ArtifactLinkResourceModel, versionIndex int) ArtifactPath {
+	return ArtifactPath{
+		EntityName:  data.EntityName.ValueString(),
+		ProjectName: registryProjectPrefix + data.Registry.ValueString(),
+		Name:        data.Collection.ValueString(),
+		Version:     fmt.Sprintf("v%d", versionIndex),
+	}
+}
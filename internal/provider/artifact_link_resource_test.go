@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccArtifactLinkResource(t *testing.T) {
+	resourceName := "wandb_artifact_link.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckArtifactLinkResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArtifactLinkResourceConfig("staging"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "terraform-acceptance-test/wandb-registry-terraform-links/example-model:v0"),
+					resource.TestCheckResourceAttr(resourceName, "version_index", "0"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "aliases.*", "staging"),
+					resource.TestCheckResourceAttrSet(resourceName, "artifact_id"),
+				),
+			},
+			{
+				Config: testAccArtifactLinkResourceConfig("production"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "version_index", "0"),
+					resource.TestCheckResourceAttr(resourceName, "aliases.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "aliases.*", "production"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckArtifactLinkResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "wandb_artifact_link" {
+			continue
+		}
+
+		linkedPath, err := parseArtifactPath(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		linked, err := readArtifactHelper(context.Background(), linkedPath, newGraphQLClient())
+		if err != nil {
+			continue
+		}
+
+		if linked != nil && linked.ID == rs.Primary.Attributes["artifact_id"] {
+			return fmt.Errorf("artifact is still linked: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccArtifactLinkResourceConfig(alias string) string {
+	return fmt.Sprintf(`
+resource "wandb_registry" "test" {
+  name           = "terraform-links"
+  entity_name    = "terraform-acceptance-test"
+  artifact_types = ["model"]
+}
+
+resource "wandb_registry_collection" "test" {
+  name        = "example-model"
+  entity_name = wandb_registry.test.entity_name
+  registry    = wandb_registry.test.name
+  type        = "model"
+}
+
+resource "wandb_artifact_link" "test" {
+  artifact    = "terraform-acceptance-test/artifacts/example-model:v0"
+  entity_name = wandb_registry_collection.test.entity_name
+  registry    = wandb_registry_collection.test.registry
+  collection  = wandb_registry_collection.test.name
+  aliases     = [%q]
+}
+`, alias)
+}
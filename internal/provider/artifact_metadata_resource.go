@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/machinebox/graphql"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ArtifactMetadataResource{}
+var _ resource.ResourceWithConfigure = &ArtifactMetadataResource{}
+var _ resource.ResourceWithImportState = &ArtifactMetadataResource{}
+
+func NewArtifactMetadataResource() resource.Resource {
+	return &ArtifactMetadataResource{}
+}
+
+type ArtifactMetadataResource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type ArtifactMetadataResourceModel struct {
+	Id          types.String   `tfsdk:"id"`
+	Artifact    types.String   `tfsdk:"artifact"`
+	Description types.String   `tfsdk:"description"`
+	Metadata    types.String   `tfsdk:"metadata"`
+	Tags        types.Set      `tfsdk:"tags"`
+	ArtifactId  types.String   `tfsdk:"artifact_id"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ArtifactMetadataResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "wandb_artifact_metadata"
+}
+
+func (r *ArtifactMetadataResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the description, metadata and tags of an existing artifact version. Only the attributes that are set are managed, and destroying the resource leaves the artifact unchanged. See [here](https://github.com/wandb/terraform-provider-wandb/blob/main/examples/resources/artifact_metadata/resource.tf) for an example",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource. This is the artifact path.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"artifact": schema.StringAttribute{
+				Required:    true,
+				Description: "The artifact version to manage, in the form entity/project/artifact:version.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "The description of the artifact version.",
+			},
+			"metadata": schema.StringAttribute{
+				Optional:    true,
+				Description: "The metadata of the artifact version. This is a JSON object string and replaces any existing metadata.",
+			},
+			"tags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The tags of the artifact version. Tags that are not listed are removed from the artifact version.",
+			},
+			"artifact_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the artifact version.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *ArtifactMetadataResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ArtifactMetadataResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ArtifactMetadataResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	artifactPath, err := parseArtifactPath(data.Artifact.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing artifact path", err.Error())
+		return
+	}
+
+	artifact, err := readArtifactHelper(ctx, artifactPath, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading artifact",
+			"Could not read artifact "+artifactPath.String()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	currentTags := make([]string, 0, len(artifact.Tags))
+	for _, tag := range artifact.Tags {
+		currentTags = append(currentTags, tag.Name)
+	}
+
+	resp.Diagnostics.Append(r.applyArtifactMetadata(ctx, artifact.ID, currentTags, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(artifactPath.String())
+	data.ArtifactId = types.StringValue(artifact.ID)
+
+	tflog.Trace(ctx, "created an artifact metadata resource")
+
+	resp.Diagnostics.Append(r.client.recordInstance(ctx, resp.Private)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactMetadataResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ArtifactMetadataResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkInstance(ctx, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	artifactPath, err := parseArtifactPath(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing artifact path", err.Error())
+		return
+	}
+
+	artifact, err := readArtifactHelper(ctx, artifactPath, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading artifact",
+			"Could not read artifact "+artifactPath.String()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Artifact = types.StringValue(artifactPath.String())
+	data.ArtifactId = types.StringValue(artifact.ID)
+
+	// Only refresh the attributes that are managed so unmanaged fields do not show up as drift.
+	// On import nothing is managed yet, so everything the artifact has is read.
+	importing := data.Description.IsNull() && data.Metadata.IsNull() && data.Tags.IsNull()
+
+	if !data.Description.IsNull() || (importing && artifact.Description != nil && *artifact.Description != "") {
+		data.Description = types.StringPointerValue(artifact.Description)
+	}
+
+	if !data.Metadata.IsNull() || (importing && artifact.Metadata != nil && *artifact.Metadata != "{}") {
+		normalizedMetadata, err := normalizeJSONObject(artifact.Metadata)
+		if err != nil {
+			resp.Diagnostics.AddError("Error normalizing artifact metadata", err.Error())
+			return
+		}
+		data.Metadata = types.StringPointerValue(normalizedMetadata)
+	}
+
+	if !data.Tags.IsNull() || (importing && len(artifact.Tags) > 0) {
+		tags := make([]attr.Value, 0, len(artifact.Tags))
+		for _, tag := range artifact.Tags {
+			tags = append(tags, types.StringValue(tag.Name))
+		}
+		tagSet, diags := types.SetValue(types.StringType, tags)
+		resp.Diagnostics.Append(diags...)
+		data.Tags = tagSet
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactMetadataResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ArtifactMetadataResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var currentTags []string
+	resp.Diagnostics.Append(state.Tags.ElementsAs(ctx, &currentTags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyArtifactMetadata(ctx, state.ArtifactId.ValueString(), currentTags, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = state.Id
+	data.ArtifactId = state.ArtifactId
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactMetadataResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The description, metadata and tags belong to the artifact version, which outlives this resource.
+	tflog.Trace(ctx, "removed an artifact metadata resource from state")
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ArtifactMetadataResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// applyArtifactMetadata sends the configured description, metadata and tag changes to the API,
+// normalizing the metadata in data to match what is read back.
+func (r *ArtifactMetadataResource) applyArtifactMetadata(ctx context.Context, artifactID string, currentTags []string, data *ArtifactMetadataResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	normalizedMetadata, err := normalizeJSONObject(data.Metadata.ValueStringPointer())
+	if err != nil {
+		diags.AddError("Error normalizing artifact metadata", err.Error())
+		return diags
+	}
+	if normalizedMetadata != nil {
+		data.Metadata = types.StringValue(*normalizedMetadata)
+	}
+
+	var tagsToAdd, tagsToDelete []string
+	if !data.Tags.IsNull() {
+		var desiredTags []string
+		diags.Append(data.Tags.ElementsAs(ctx, &desiredTags, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		tagsToAdd, tagsToDelete = diffStringSets(currentTags, desiredTags)
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation UpdateArtifact(
+			$artifactID: ID!,
+			$description: String,
+			$metadata: JSONString,
+			$tagsToAdd: [TagInput!],
+			$tagsToDelete: [TagInput!],
+		) {
+			updateArtifact(input: {
+				artifactID: $artifactID,
+				description: $description,
+				metadata: $metadata,
+				tagsToAdd: $tagsToAdd,
+				tagsToDelete: $tagsToDelete,
+			}) {
+				artifact {
+					id
+				}
+			}
+		}
+	`)
+	gqlReq.Var("artifactID", artifactID)
+	gqlReq.Var("description", data.Description.ValueStringPointer())
+	gqlReq.Var("metadata", normalizedMetadata)
+	gqlReq.Var("tagsToAdd", tagInputs(tagsToAdd))
+	gqlReq.Var("tagsToDelete", tagInputs(tagsToDelete))
+
+	var result struct {
+		UpdateArtifact struct {
+			Artifact *struct {
+				ID string `json:"id"`
+			} `json:"artifact"`
+		} `json:"updateArtifact"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		diags.AddError(
+			"Error updating artifact",
+			"Could not update artifact, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	if result.UpdateArtifact.Artifact == nil {
+		diags.AddError(
+			"Failed to update artifact",
+			"The API did not confirm the update of the artifact.",
+		)
+	}
+
+	return diags
+}
+
+func tagInputs(tags []string) []map[string]string {
+	if len(tags) == 0 {
+		return nil
+	}
+	inputs := make([]map[string]string, 0, len(tags))
+	for _, tag := range tags {
+		inputs = append(inputs, map[string]string{"tagName": tag})
+	}
+	return inputs
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArtifactMetadataResource(t *testing.T) {
+	resourceName := "wandb_artifact_metadata.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArtifactMetadataResourceConfig("first description", "candidate"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "terraform-acceptance-test/artifacts/example-model:v0"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first description"),
+					resource.TestCheckResourceAttr(resourceName, "metadata", `{"accuracy":0.9}`),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "candidate"),
+				),
+			},
+			{
+				Config: testAccArtifactMetadataResourceConfig("second description", "approved"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "second description"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "approved"),
+				),
+			},
+		},
+	})
+}
+
+func testAccArtifactMetadataResourceConfig(description, tag string) string {
+	return fmt.Sprintf(`
+resource "wandb_artifact_metadata" "test" {
+  artifact    = "terraform-acceptance-test/artifacts/example-model:v0"
+  description = %q
+  metadata = jsonencode({
+    accuracy = 0.9
+  })
+  tags = [%q]
+}
+`, description, tag)
+}
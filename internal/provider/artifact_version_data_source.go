@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ArtifactVersionDataSource{}
+var _ datasource.DataSourceWithConfigure = &ArtifactVersionDataSource{}
+
+func NewArtifactVersionDataSource() datasource.DataSource {
+	return &ArtifactVersionDataSource{}
+}
+
+type ArtifactVersionDataSource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type ArtifactVersionDataSourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Artifact     types.String `tfsdk:"artifact"`
+	Version      types.String `tfsdk:"version"`
+	VersionIndex types.Int64  `tfsdk:"version_index"`
+	Digest       types.String `tfsdk:"digest"`
+	Type         types.String `tfsdk:"type"`
+	Aliases      types.List   `tfsdk:"aliases"`
+}
+
+func (d *ArtifactVersionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "wandb_artifact_version"
+}
+
+func (d *ArtifactVersionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resolves an artifact alias, such as `production` or `latest`, to a concrete artifact version and digest.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the artifact version.",
+			},
+			"artifact": schema.StringAttribute{
+				Required:    true,
+				Description: "The artifact to resolve, in the form entity/project/artifact:alias.",
+			},
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The version the alias resolves to, for example 'v3'.",
+			},
+			"version_index": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The numeric index of the version within the artifact collection.",
+			},
+			"digest": schema.StringAttribute{
+				Computed:    true,
+				Description: "The content digest of the artifact version.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The artifact type, for example 'model' or 'dataset'.",
+			},
+			"aliases": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "All aliases attached to the artifact version within its collection.",
+			},
+		},
+	}
+}
+
+func (d *ArtifactVersionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ArtifactVersionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ArtifactVersionDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	artifactPath, err := parseArtifactPath(data.Artifact.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing artifact path", err.Error())
+		return
+	}
+
+	artifact, err := readArtifactHelper(ctx, artifactPath, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading artifact",
+			"Could not read artifact "+artifactPath.String()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if artifact.VersionIndex == nil {
+		resp.Diagnostics.AddError(
+			"Error reading artifact",
+			"Artifact "+artifactPath.String()+" has not been committed and has no version.",
+		)
+		return
+	}
+
+	aliases := make([]attr.Value, 0, len(artifact.Aliases))
+	for _, alias := range artifact.Aliases {
+		if alias.ArtifactCollectionName == artifactPath.Name {
+			aliases = append(aliases, types.StringValue(alias.Alias))
+		}
+	}
+	aliasList, diags := types.ListValue(types.StringType, aliases)
+	resp.Diagnostics.Append(diags...)
+
+	data.Id = types.StringValue(artifact.ID)
+	data.Version = types.StringValue(fmt.Sprintf("v%d", *artifact.VersionIndex))
+	data.VersionIndex = types.Int64Value(int64(*artifact.VersionIndex))
+	data.Digest = types.StringValue(artifact.Digest)
+	data.Type = types.StringValue(artifact.ArtifactType.Name)
+	data.Aliases = aliasList
+
+	tflog.Trace(ctx, "read an artifact version data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArtifactVersionDataSource(t *testing.T) {
+	dataSourceName := "data.wandb_artifact_version.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArtifactVersionDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "wandb_artifact_alias.test", "artifact_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "version", "v0"),
+					resource.TestCheckResourceAttr(dataSourceName, "version_index", "0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "digest"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "aliases.*", "terraform-lookup"),
+				),
+			},
+		},
+	})
+}
+
+func testAccArtifactVersionDataSourceConfig() string {
+	return `
+resource "wandb_artifact_alias" "test" {
+  artifact = "terraform-acceptance-test/artifacts/example-model:v0"
+  alias    = "terraform-lookup"
+}
+
+data "wandb_artifact_version" "test" {
+  artifact = "terraform-acceptance-test/artifacts/example-model:${wandb_artifact_alias.test.alias}"
+}
+`
+}
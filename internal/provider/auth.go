@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const identityTokenGrantType = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+
+// apiKeyAuthorization returns the Authorization header value for an API key.
+func apiKeyAuthorization(apiKey string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte("api:"+apiKey))
+}
+
+// readAPIKeyFile reads an API key from a file, ignoring surrounding whitespace.
+func readAPIKeyFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	apiKey := strings.TrimSpace(string(content))
+	if apiKey == "" {
+		return "", fmt.Errorf("api key file %s is empty", path)
+	}
+	return apiKey, nil
+}
+
+// netrcPath returns the path of the netrc file the wandb CLI writes credentials to.
+func netrcPath() (string, error) {
+	if path := os.Getenv("NETRC"); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".netrc"), nil
+}
+
+// readNetrcAPIKey returns the password of the netrc entry for the host of baseURL, which is where
+// `wandb login` stores the API key. An empty key is returned if there is no matching entry.
+func readNetrcAPIKey(path, baseURL string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// Entries may use the host with or without the port, so the host with the port is preferred.
+	passwords := map[string]string{}
+	var machine, defaultPassword string
+	var isDefault bool
+	tokens := strings.Fields(string(content))
+	for i := 0; i < len(tokens); i++ {
+		switch tokens[i] {
+		case "machine":
+			if i+1 < len(tokens) {
+				i++
+				machine, isDefault = tokens[i], false
+			}
+		case "default":
+			machine, isDefault = "", true
+		case "password":
+			if i+1 < len(tokens) {
+				i++
+				if isDefault {
+					defaultPassword = tokens[i]
+				} else if machine != "" {
+					passwords[machine] = tokens[i]
+				}
+			}
+		case "login", "account":
+			i++
+		case "macdef":
+			// Macro definitions run to the end of the file in practice, and never hold credentials.
+			i = len(tokens)
+		}
+	}
+
+	if password, ok := passwords[u.Host]; ok {
+		return password, nil
+	}
+	if password, ok := passwords[u.Hostname()]; ok {
+		return password, nil
+	}
+	return defaultPassword, nil
+}
+
+// exchangeIdentityToken exchanges the workload identity token in tokenFile for a W&B access token,
+// using the OAuth 2.0 JWT bearer grant of the W&B OIDC token endpoint.
+func exchangeIdentityToken(ctx context.Context, httpClient *http.Client, baseURL, tokenFile string) (string, time.Duration, error) {
+	content, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return "", 0, err
+	}
+	identityToken := strings.TrimSpace(string(content))
+	if identityToken == "" {
+		return "", 0, fmt.Errorf("identity token file %s is empty", tokenFile)
+	}
+
+	form := url.Values{
+		"grant_type": []string{identityTokenGrantType},
+		"assertion":  []string{identityToken},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(baseURL, "/")+"/oidc/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return "", 0, err
+	}
+	defer res.Body.Close()
+
+	var result struct {
+		AccessToken      string `json:"access_token"`
+		ExpiresIn        int64  `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil && res.StatusCode == http.StatusOK {
+		return "", 0, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		if result.Error != "" {
+			return "", 0, fmt.Errorf("token exchange failed with status %d: %s %s", res.StatusCode, result.Error, result.ErrorDescription)
+		}
+		return "", 0, fmt.Errorf("token exchange failed with status %d", res.StatusCode)
+	}
+
+	if result.AccessToken == "" {
+		return "", 0, fmt.Errorf("token exchange did not return an access token")
+	}
+
+	return result.AccessToken, time.Duration(result.ExpiresIn) * time.Second, nil
+}
+
+// identityTokenRefreshMargin is how long before an access token expires it is exchanged again, so that
+// requests in flight do not use an expired token.
+const identityTokenRefreshMargin = time.Minute
+
+// identityTokenSource provides the Authorization header for a workload identity token, exchanging the
+// token again shortly before each access token expires. The identity token file is read on every
+// exchange, so tokens rotated by the platform, such as projected Kubernetes service account tokens,
+// are picked up.
+type identityTokenSource struct {
+	httpClient *http.Client
+	baseURL    string
+	tokenFile  string
+
+	mu          sync.Mutex
+	accessToken string
+	// expiresAt is when the access token expires, or zero if the exchange did not report it.
+	expiresAt time.Time
+}
+
+// authorization returns the Authorization header value, exchanging the identity token if there is no
+// access token yet or it is about to expire.
+func (s *identityTokenSource) authorization(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.accessToken != "" && (s.expiresAt.IsZero() || time.Until(s.expiresAt) > identityTokenRefreshMargin) {
+		return "Bearer " + s.accessToken, nil
+	}
+
+	accessToken, expiresIn, err := exchangeIdentityToken(ctx, s.httpClient, s.baseURL, s.tokenFile)
+	if err != nil {
+		return "", err
+	}
+	s.accessToken = accessToken
+	s.expiresAt = time.Time{}
+	if expiresIn > 0 {
+		s.expiresAt = time.Now().Add(expiresIn)
+	}
+	return "Bearer " + s.accessToken, nil
+}
@@ -0,0 +1,846 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/machinebox/graphql"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AutomationResource{}
+var _ resource.ResourceWithConfigure = &AutomationResource{}
+var _ resource.ResourceWithValidateConfig = &AutomationResource{}
+var _ resource.ResourceWithImportState = &AutomationResource{}
+
+func NewAutomationResource() resource.Resource {
+	return &AutomationResource{}
+}
+
+type AutomationResource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type AutomationResourceModel struct {
+	Id          types.String           `tfsdk:"id"`
+	Name        types.String           `tfsdk:"name"`
+	Description types.String           `tfsdk:"description"`
+	Enabled     types.Bool             `tfsdk:"enabled"`
+	Event       *AutomationEventModel  `tfsdk:"event"`
+	Scope       *AutomationScopeModel  `tfsdk:"scope"`
+	Action      *AutomationActionModel `tfsdk:"action"`
+	Timeouts    timeouts.Value         `tfsdk:"timeouts"`
+}
+
+type AutomationEventModel struct {
+	Type       types.String           `tfsdk:"type"`
+	AliasRegex types.String           `tfsdk:"alias_regex"`
+	Metric     *AutomationMetricModel `tfsdk:"metric"`
+}
+
+type AutomationMetricModel struct {
+	Name        types.String  `tfsdk:"name"`
+	Operator    types.String  `tfsdk:"operator"`
+	Threshold   types.Float64 `tfsdk:"threshold"`
+	WindowSize  types.Int64   `tfsdk:"window_size"`
+	Aggregation types.String  `tfsdk:"aggregation"`
+}
+
+type AutomationScopeModel struct {
+	Type       types.String `tfsdk:"type"`
+	EntityName types.String `tfsdk:"entity_name"`
+	Name       types.String `tfsdk:"name"`
+}
+
+type AutomationActionModel struct {
+	Type          types.String `tfsdk:"type"`
+	QueueId       types.String `tfsdk:"queue_id"`
+	Template      types.String `tfsdk:"template"`
+	IntegrationId types.String `tfsdk:"integration_id"`
+	Payload       types.String `tfsdk:"payload"`
+	Title         types.String `tfsdk:"title"`
+	Message       types.String `tfsdk:"message"`
+	Severity      types.String `tfsdk:"severity"`
+}
+
+func (r *AutomationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "wandb_automation"
+}
+
+func (r *AutomationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Automation resource that runs an action when an event occurs in a project or registry, for example launching an evaluation job onto a run queue when an alias is added. See: https://docs.wandb.ai/guides/automations. See [here](https://github.com/wandb/terraform-provider-wandb/blob/main/examples/resources/automation/resource.tf) for an example",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the automation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the automation.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "The description of the automation.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the automation is enabled. Defaults to true.",
+			},
+			"event": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "The event that triggers the automation.",
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Required:    true,
+						Description: "The event type. Options include: artifact_alias_added, artifact_linked and run_metric_threshold.",
+					},
+					"alias_regex": schema.StringAttribute{
+						Optional:    true,
+						Description: "For artifact_alias_added events, a regular expression the added alias must match. Defaults to any alias.",
+					},
+					"metric": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "For run_metric_threshold events, the metric condition that triggers the automation.",
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								Required:    true,
+								Description: "The name of the run metric.",
+							},
+							"operator": schema.StringAttribute{
+								Required:    true,
+								Description: "The comparison operator. Options include: >, >=, < and <=.",
+							},
+							"threshold": schema.Float64Attribute{
+								Required:    true,
+								Description: "The value the metric is compared against.",
+							},
+							"window_size": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The number of most recent metric values to aggregate. Defaults to 1.",
+							},
+							"aggregation": schema.StringAttribute{
+								Optional:    true,
+								Description: "How metric values within the window are aggregated. Options include: MAX, MIN and AVERAGE. Defaults to AVERAGE.",
+							},
+						},
+					},
+				},
+			},
+			"scope": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "The project or registry the event is watched in. Changing the scope forces a new automation to be created.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Required:    true,
+						Description: "The scope type. Options include: project and registry.",
+					},
+					"entity_name": schema.StringAttribute{
+						Required:    true,
+						Description: "The name of the entity that owns the project or registry.",
+					},
+					"name": schema.StringAttribute{
+						Required:    true,
+						Description: "The name of the project, or of the registry without the 'wandb-registry-' prefix.",
+					},
+				},
+			},
+			"action": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "The action run when the event occurs.",
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Required:    true,
+						Description: "The action type. Options include: queue_job, webhook and notification.",
+					},
+					"queue_id": schema.StringAttribute{
+						Optional:    true,
+						Description: "For queue_job actions, the ID of the wandb_run_queue to launch the job onto.",
+					},
+					"template": schema.StringAttribute{
+						Optional:    true,
+						Description: "For queue_job actions, the launch template as a JSON string, for example the job and its run config.",
+					},
+					"integration_id": schema.StringAttribute{
+						Optional:    true,
+						Description: "For webhook and notification actions, the ID of the wandb_webhook or Slack integration to send to.",
+					},
+					"payload": schema.StringAttribute{
+						Optional:    true,
+						Description: "For webhook actions, the JSON request payload template. Values may reference event data with ${variable} placeholders inside JSON strings.",
+					},
+					"title": schema.StringAttribute{
+						Optional:    true,
+						Description: "For notification actions, the title of the notification.",
+					},
+					"message": schema.StringAttribute{
+						Optional:    true,
+						Description: "For notification actions, the message of the notification.",
+					},
+					"severity": schema.StringAttribute{
+						Optional:    true,
+						Description: "For notification actions, the severity of the notification. Options include: INFO, WARN and ERROR. Defaults to INFO.",
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *AutomationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AutomationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Event != nil && !data.Event.Type.IsUnknown() {
+		eventType := data.Event.Type.ValueString()
+		if _, ok := automationEventTypes[eventType]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("event").AtName("type"),
+				"Invalid automation event type",
+				"event.type must be one of: artifact_alias_added, artifact_linked, run_metric_threshold.",
+			)
+		}
+		if eventType == "run_metric_threshold" && data.Event.Metric == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("event").AtName("metric"),
+				"Missing automation metric",
+				"event.metric must be specified for run_metric_threshold events.",
+			)
+		}
+		if data.Event.Metric != nil && !data.Event.Metric.Operator.IsUnknown() {
+			if _, ok := automationMetricOperators[data.Event.Metric.Operator.ValueString()]; !ok {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("event").AtName("metric").AtName("operator"),
+					"Invalid automation metric operator",
+					"event.metric.operator must be one of: >, >=, <, <=.",
+				)
+			}
+		}
+	}
+
+	if data.Scope != nil && !data.Scope.Type.IsUnknown() {
+		if scopeType := data.Scope.Type.ValueString(); scopeType != "project" && scopeType != "registry" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("scope").AtName("type"),
+				"Invalid automation scope type",
+				"scope.type must be one of: project, registry.",
+			)
+		}
+	}
+
+	if data.Action != nil {
+		// The payload template may contain ${variable} placeholders, but must still be a JSON object.
+		jsonAttributes := []struct {
+			name  string
+			value types.String
+		}{
+			{"template", data.Action.Template},
+			{"payload", data.Action.Payload},
+		}
+		for _, attribute := range jsonAttributes {
+			name, value := attribute.name, attribute.value
+			if value.IsNull() || value.IsUnknown() {
+				continue
+			}
+			if _, err := normalizeJSONObject(value.ValueStringPointer()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("action").AtName(name),
+					"Invalid automation action JSON",
+					"action."+name+" must be a JSON object: "+err.Error(),
+				)
+			}
+		}
+	}
+
+	if data.Action != nil && !data.Action.Type.IsUnknown() {
+		switch data.Action.Type.ValueString() {
+		case "queue_job":
+			if data.Action.QueueId.IsNull() || data.Action.Template.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("action"),
+					"Missing automation action attributes",
+					"action.queue_id and action.template must be specified for queue_job actions.",
+				)
+			}
+		case "webhook", "notification":
+			if data.Action.IntegrationId.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("action").AtName("integration_id"),
+					"Missing automation action attributes",
+					"action.integration_id must be specified for webhook and notification actions.",
+				)
+			}
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("action").AtName("type"),
+				"Invalid automation action type",
+				"action.type must be one of: queue_job, webhook, notification.",
+			)
+		}
+	}
+}
+
+func (r *AutomationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(client.requireServerSupport(ctx, "wandb_automation", "Mutation", "createFilterTrigger")...)
+	r.client = client
+}
+
+func (r *AutomationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AutomationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vars, diags := r.triggerVariables(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scopeID, err := readProjectIDHelper(ctx, data.Scope.EntityName.ValueString(), automationScopeProjectName(data.Scope), r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading automation scope",
+			"Could not read automation scope, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation CreateFilterTrigger(
+			$name: String!,
+			$description: String,
+			$triggeringEventType: EventTriggeringConditionType!,
+			$scopeType: TriggerScopeType!,
+			$scopeID: ID!,
+			$eventFilter: JSONString!,
+			$triggeredActionType: TriggeredActionType!,
+			$triggeredActionConfig: TriggeredActionConfig!,
+			$enabled: Boolean!,
+		) {
+			createFilterTrigger(input: {
+				name: $name,
+				description: $description,
+				triggeringEventType: $triggeringEventType,
+				scopeType: $scopeType,
+				scopeID: $scopeID,
+				eventFilter: $eventFilter,
+				triggeredActionType: $triggeredActionType,
+				triggeredActionConfig: $triggeredActionConfig,
+				enabled: $enabled,
+			}) {
+				trigger {
+					id
+				}
+			}
+		}
+	`)
+	for key, value := range vars {
+		gqlReq.Var(key, value)
+	}
+	gqlReq.Var("scopeType", "PROJECT")
+	gqlReq.Var("scopeID", scopeID)
+
+	var result struct {
+		CreateFilterTrigger struct {
+			Trigger *struct {
+				ID string `json:"id"`
+			} `json:"trigger"`
+		} `json:"createFilterTrigger"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating automation",
+			"Could not create automation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if result.CreateFilterTrigger.Trigger == nil {
+		resp.Diagnostics.AddError(
+			"Failed to create automation",
+			"The API did not return the created automation.",
+		)
+		return
+	}
+
+	data.Id = types.StringValue(result.CreateFilterTrigger.Trigger.ID)
+
+	tflog.Trace(ctx, "created an automation resource")
+
+	resp.Diagnostics.Append(r.client.recordInstance(ctx, resp.Private)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutomationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AutomationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkInstance(ctx, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	trigger, err := readTriggerHelper(ctx, data.Scope.EntityName.ValueString(), automationScopeProjectName(data.Scope), data.Id.ValueString(), r.client)
+	if errors.Is(err, errAutomationNotFound) {
+		tflog.Warn(ctx, "automation no longer exists, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading automation",
+			"Could not read automation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setAutomationResourceModel(&data, trigger)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutomationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AutomationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vars, diags := r.triggerVariables(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation UpdateFilterTrigger(
+			$id: ID!,
+			$name: String!,
+			$description: String,
+			$triggeringEventType: EventTriggeringConditionType!,
+			$eventFilter: JSONString!,
+			$triggeredActionType: TriggeredActionType!,
+			$triggeredActionConfig: TriggeredActionConfig!,
+			$enabled: Boolean!,
+		) {
+			updateFilterTrigger(input: {
+				id: $id,
+				name: $name,
+				description: $description,
+				triggeringEventType: $triggeringEventType,
+				eventFilter: $eventFilter,
+				triggeredActionType: $triggeredActionType,
+				triggeredActionConfig: $triggeredActionConfig,
+				enabled: $enabled,
+			}) {
+				trigger {
+					id
+				}
+			}
+		}
+	`)
+	for key, value := range vars {
+		gqlReq.Var(key, value)
+	}
+	gqlReq.Var("id", data.Id.ValueString())
+
+	var result struct {
+		UpdateFilterTrigger struct {
+			Trigger *struct {
+				ID string `json:"id"`
+			} `json:"trigger"`
+		} `json:"updateFilterTrigger"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating automation",
+			"Could not update automation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if result.UpdateFilterTrigger.Trigger == nil {
+		resp.Diagnostics.AddError(
+			"Failed to update automation",
+			"The API did not confirm the update of the automation.",
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutomationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AutomationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation DeleteTrigger($triggerID: ID!) {
+			deleteTrigger(input: {triggerID: $triggerID}) {
+				success
+			}
+		}
+	`)
+	gqlReq.Var("triggerID", data.Id.ValueString())
+
+	var result struct {
+		DeleteTrigger struct {
+			Success bool `json:"success"`
+		} `json:"deleteTrigger"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting automation",
+			"Could not delete automation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !result.DeleteTrigger.Success {
+		resp.Diagnostics.AddError(
+			"Failed to delete automation",
+			"The API did not confirm the deletion of the automation.",
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an automation resource")
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *AutomationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	entityName, projectName, triggerID, err := parseAutomationID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing import ID",
+			"Expected an import ID of the form entity_name:project_name:automation_id, where project_name is wandb-registry-<name> for registries: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), triggerID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scope"), automationScopeModel(entityName, projectName))...)
+}
+
+// setAutomationResourceModel refreshes data from the trigger. Optional attributes that are unset in
+// data are kept null when the API reports their default.
+func setAutomationResourceModel(data *AutomationResourceModel, trigger *Trigger) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Name = types.StringValue(trigger.Name)
+	data.Enabled = types.BoolValue(trigger.Enabled)
+	if !data.Description.IsNull() || (trigger.Description != nil && *trigger.Description != "") {
+		data.Description = types.StringPointerValue(trigger.Description)
+	}
+	if trigger.Scope.Name != "" {
+		data.Scope = automationScopeModel(trigger.Scope.Entity.Name, trigger.Scope.Name)
+	}
+
+	eventType, aliasRegex, metric, err := parseAutomationEventFilter(trigger.TriggeringCondition.EventType, trigger.TriggeringCondition.Filter)
+	if err != nil {
+		diags.AddError(
+			"Error reading automation event",
+			"Could not read the event of the automation, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	var prior AutomationEventModel
+	if data.Event != nil {
+		prior = *data.Event
+	}
+	event := &AutomationEventModel{Type: types.StringValue(eventType)}
+	if aliasRegex != "" && (aliasRegex != ".*" || !prior.AliasRegex.IsNull()) {
+		event.AliasRegex = types.StringValue(aliasRegex)
+	}
+	if metric != nil {
+		var priorMetric AutomationMetricModel
+		if prior.Metric != nil {
+			priorMetric = *prior.Metric
+		}
+		event.Metric = &AutomationMetricModel{
+			Name:      types.StringValue(metric.Name),
+			Operator:  types.StringValue(metric.CmpOp),
+			Threshold: types.Float64Value(metric.Threshold),
+		}
+		if metric.WindowSize != 1 || !priorMetric.WindowSize.IsNull() {
+			event.Metric.WindowSize = types.Int64Value(metric.WindowSize)
+		}
+		if metric.AggOp != "AVERAGE" || !priorMetric.Aggregation.IsNull() {
+			event.Metric.Aggregation = types.StringValue(metric.AggOp)
+		}
+	}
+	data.Event = event
+
+	var priorAction AutomationActionModel
+	if data.Action != nil {
+		priorAction = *data.Action
+	}
+	apiAction := trigger.TriggeredAction
+	action := &AutomationActionModel{}
+	if apiAction.Integration != nil {
+		action.IntegrationId = types.StringValue(apiAction.Integration.ID)
+	}
+	switch apiAction.Typename {
+	case "QueueJobTriggeredAction":
+		action.Type = types.StringValue("queue_job")
+		if apiAction.Queue != nil {
+			action.QueueId = types.StringValue(generateCompositeID(apiAction.Queue.EntityName, apiAction.Queue.Name))
+		}
+		action.Template = automationJSONValue(priorAction.Template, apiAction.Template)
+	case "GenericWebhookTriggeredAction":
+		action.Type = types.StringValue("webhook")
+		action.Payload = automationJSONValue(priorAction.Payload, apiAction.RequestPayload)
+	case "NotificationTriggeredAction":
+		action.Type = types.StringValue("notification")
+		action.Title = stringPointerValueOrNull(apiAction.Title)
+		action.Message = stringPointerValueOrNull(apiAction.Message)
+		if apiAction.Severity != nil && (*apiAction.Severity != "INFO" || !priorAction.Severity.IsNull()) {
+			action.Severity = types.StringValue(*apiAction.Severity)
+		}
+	default:
+		diags.AddError(
+			"Error reading automation action",
+			"Unsupported automation action type: "+apiAction.Typename,
+		)
+		return diags
+	}
+	data.Action = action
+
+	return diags
+}
+
+// automationJSONValue returns the JSON value read from the API, or prior if it is the same JSON
+// object, so that formatting differences do not cause a diff.
+func automationJSONValue(prior types.String, value *string) types.String {
+	normalized, err := normalizeJSONObject(value)
+	if err != nil {
+		return types.StringPointerValue(value)
+	}
+	if normalized == nil {
+		return types.StringNull()
+	}
+	if priorNormalized, err := normalizeJSONObject(prior.ValueStringPointer()); err == nil && priorNormalized != nil && *priorNormalized == *normalized {
+		return prior
+	}
+	return types.StringValue(*normalized)
+}
+
+// automationScopeModel returns the scope of an automation watching the named project, which is a
+// registry if the project name has the registry prefix.
+func automationScopeModel(entityName, projectName string) *AutomationScopeModel {
+	scope := &AutomationScopeModel{
+		Type:       types.StringValue("project"),
+		EntityName: types.StringValue(entityName),
+		Name:       types.StringValue(projectName),
+	}
+	if registryName, ok := strings.CutPrefix(projectName, registryProjectPrefix); ok {
+		scope.Type = types.StringValue("registry")
+		scope.Name = types.StringValue(registryName)
+	}
+	return scope
+}
+
+// triggerVariables builds the GraphQL variables shared by the create and update trigger mutations.
+func (r *AutomationResource) triggerVariables(ctx context.Context, data *AutomationResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var metric *AutomationMetricFilter
+	if m := data.Event.Metric; m != nil {
+		metric = &AutomationMetricFilter{
+			Name:       m.Name.ValueString(),
+			WindowSize: 1,
+			AggOp:      "AVERAGE",
+			CmpOp:      m.Operator.ValueString(),
+			Threshold:  m.Threshold.ValueFloat64(),
+		}
+		if !m.WindowSize.IsNull() {
+			metric.WindowSize = m.WindowSize.ValueInt64()
+		}
+		if !m.Aggregation.IsNull() {
+			metric.AggOp = m.Aggregation.ValueString()
+		}
+	}
+
+	eventFilter, err := buildAutomationEventFilter(data.Event.Type.ValueString(), data.Event.AliasRegex.ValueString(), metric)
+	if err != nil {
+		diags.AddError("Error building automation event filter", err.Error())
+		return nil, diags
+	}
+
+	var actionType string
+	actionConfig := map[string]interface{}{}
+	switch data.Action.Type.ValueString() {
+	case "queue_job":
+		entityName, queueName, err := parseCompositeID(data.Action.QueueId.ValueString())
+		if err != nil {
+			diags.AddError("Error parsing run queue ID", err.Error())
+			return nil, diags
+		}
+		runQueue, err := readRunQueueHelper(entityName, queueName, ctx, *r.client)
+		if err != nil {
+			diags.AddError(
+				"Error reading run queue",
+				"Could not read run queue for automation action, unexpected error: "+err.Error(),
+			)
+			return nil, diags
+		}
+		template, err := normalizeJSONObject(data.Action.Template.ValueStringPointer())
+		if err != nil {
+			diags.AddError("Error normalizing automation template", err.Error())
+			return nil, diags
+		}
+		actionType = "QUEUE_JOB"
+		actionConfig["queueJobActionInput"] = map[string]interface{}{
+			"queueID":  runQueue.ID,
+			"template": template,
+		}
+	case "webhook":
+		actionType = "GENERIC_WEBHOOK"
+		actionConfig["genericWebhookActionInput"] = map[string]interface{}{
+			"integrationID":  data.Action.IntegrationId.ValueString(),
+			"requestPayload": data.Action.Payload.ValueStringPointer(),
+		}
+	case "notification":
+		severity := "INFO"
+		if !data.Action.Severity.IsNull() {
+			severity = data.Action.Severity.ValueString()
+		}
+		actionType = "NOTIFICATION"
+		actionConfig["notificationActionInput"] = map[string]interface{}{
+			"integrationID": data.Action.IntegrationId.ValueString(),
+			"title":         data.Action.Title.ValueString(),
+			"message":       data.Action.Message.ValueString(),
+			"severity":      severity,
+		}
+	default:
+		diags.AddError("Invalid automation action type", "Unsupported action type: "+data.Action.Type.ValueString())
+		return nil, diags
+	}
+
+	return map[string]interface{}{
+		"name":                  data.Name.ValueString(),
+		"description":           data.Description.ValueStringPointer(),
+		"triggeringEventType":   automationEventTypes[data.Event.Type.ValueString()],
+		"eventFilter":           eventFilter,
+		"triggeredActionType":   actionType,
+		"triggeredActionConfig": actionConfig,
+		"enabled":               data.Enabled.ValueBool(),
+	}, diags
+}
+
+// automationScopeProjectName returns the name of the project backing the automation's scope.
+func automationScopeProjectName(scope *AutomationScopeModel) string {
+	if scope.Type.ValueString() == "registry" {
+		return registryProjectPrefix + scope.Name.ValueString()
+	}
+	return scope.Name.ValueString()
+}
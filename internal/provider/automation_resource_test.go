@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAutomationResource(t *testing.T) {
+	resourceName := "wandb_automation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckAutomationResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationResourceConfig("production", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "terraform-evaluate-on-alias"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "event.alias_regex", "production"),
+					resource.TestCheckResourceAttr(resourceName, "action.type", "queue_job"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAutomationImportStateID(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAutomationResourceConfig("staging|production", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "event.alias_regex", "staging|production"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAutomationResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "wandb_automation" {
+			continue
+		}
+
+		_, err := readTriggerHelper(context.Background(), rs.Primary.Attributes["scope.entity_name"], rs.Primary.Attributes["scope.name"], rs.Primary.ID, newGraphQLClient())
+		if err == nil {
+			return fmt.Errorf("automation still exists: %s", rs.Primary.ID)
+		}
+		if !errors.Is(err, errAutomationNotFound) {
+			return fmt.Errorf("checking that automation %s was destroyed: %w", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccAutomationImportStateID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s:%s:%s", rs.Primary.Attributes["scope.entity_name"], rs.Primary.Attributes["scope.name"], rs.Primary.ID), nil
+	}
+}
+
+func TestSetAutomationResourceModel(t *testing.T) {
+	description := ""
+	payload := `{"text": "${alias} added"}`
+	trigger := &Trigger{
+		ID:          "trigger-id",
+		Name:        "notify-on-alias",
+		Description: &description,
+		Enabled:     true,
+		TriggeringCondition: TriggeringCondition{
+			EventType: "ADD_ARTIFACT_ALIAS",
+			Filter:    `{"$or": [{"$and": [{"alias": {"$regex": ".*"}}]}]}`,
+		},
+		TriggeredAction: TriggeredAction{
+			Typename:       "GenericWebhookTriggeredAction",
+			Integration:    &TriggerIntegration{ID: "integration-id"},
+			RequestPayload: &payload,
+		},
+	}
+	trigger.Scope.Name = "wandb-registry-model"
+	trigger.Scope.Entity.Name = "example-entity"
+
+	// An imported automation has no prior event or action.
+	var data AutomationResourceModel
+	assert.False(t, setAutomationResourceModel(&data, trigger).HasError())
+	assert.Equal(t, "notify-on-alias", data.Name.ValueString())
+	assert.True(t, data.Description.IsNull())
+	assert.Equal(t, &AutomationScopeModel{
+		Type:       types.StringValue("registry"),
+		EntityName: types.StringValue("example-entity"),
+		Name:       types.StringValue("model"),
+	}, data.Scope)
+	assert.Equal(t, &AutomationEventModel{Type: types.StringValue("artifact_alias_added")}, data.Event)
+	assert.Equal(t, &AutomationActionModel{
+		Type:          types.StringValue("webhook"),
+		IntegrationId: types.StringValue("integration-id"),
+		Payload:       types.StringValue(`{"text":"${alias} added"}`),
+	}, data.Action)
+
+	// Formatting of the configured payload is kept, and changes made outside of Terraform are read.
+	data.Action.Payload = types.StringValue(payload)
+	trigger.TriggeringCondition = TriggeringCondition{
+		EventType: "RUN_METRIC",
+		Filter:    `{"run_filter": {}, "metric_filter": {"name": "loss", "window_size": 1, "agg_op": "AVERAGE", "cmp_op": "$lt", "threshold": 0.5}}`,
+	}
+	assert.False(t, setAutomationResourceModel(&data, trigger).HasError())
+	assert.Equal(t, payload, data.Action.Payload.ValueString())
+	assert.Equal(t, &AutomationMetricModel{
+		Name:      types.StringValue("loss"),
+		Operator:  types.StringValue("<"),
+		Threshold: types.Float64Value(0.5),
+	}, data.Event.Metric)
+
+	trigger.TriggeredAction = TriggeredAction{Typename: "NoOpTriggeredAction"}
+	assert.True(t, setAutomationResourceModel(&data, trigger).HasError())
+}
+
+func testAccAutomationResourceConfig(aliasRegex string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "wandb_run_queue" "test" {
+  name        = "terraform-automation-queue"
+  entity_name = "terraform-acceptance-test"
+  resource    = "local-container"
+}
+
+resource "wandb_automation" "test" {
+  name    = "terraform-evaluate-on-alias"
+  enabled = %t
+
+  event = {
+    type        = "artifact_alias_added"
+    alias_regex = %q
+  }
+
+  scope = {
+    type        = "project"
+    entity_name = "terraform-acceptance-test"
+    name        = "terraform-automations"
+  }
+
+  action = {
+    type     = "queue_job"
+    queue_id = wandb_run_queue.test.id
+    template = jsonencode({
+      job = "terraform-acceptance-test/terraform-automations/job-evaluate:latest"
+    })
+  }
+}
+`, enabled, aliasRegex)
+}
+
+func TestAutomationResourceRead_NotFound(t *testing.T) {
+	client, _ := newIntrospectionTestServer(t, nil, `{"data": {"project": {"triggers": [{"id": "other-automation"}]}}}`)
+	r := &AutomationResource{client: client}
+
+	scopeType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"type":        tftypes.String,
+		"entity_name": tftypes.String,
+		"name":        tftypes.String,
+	}}
+	resp := testRead(r, testResourceState(t, r, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "missing-automation"),
+		"name": tftypes.NewValue(tftypes.String, "example"),
+		"scope": tftypes.NewValue(scopeType, map[string]tftypes.Value{
+			"type":        tftypes.NewValue(tftypes.String, "project"),
+			"entity_name": tftypes.NewValue(tftypes.String, "terraform-acceptance-test"),
+			"name":        tftypes.NewValue(tftypes.String, "example-project"),
+		}),
+	}))
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
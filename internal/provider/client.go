@@ -1,38 +1,460 @@
 package provider
 
 import (
+	"bytes"
 	"context"
-	"log"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"os"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/machinebox/graphql"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type GraphQLClientWithHeaders struct {
-	client  *graphql.Client
-	headers http.Header
+	client     *graphql.Client
+	headers    http.Header
+	baseURL    string
+	httpClient *http.Client
+
+	// defaultEntity and defaultProject are used by resources that omit entity_name or project_name.
+	defaultEntity  string
+	defaultProject string
+
+	// serverFields caches the fields of the GraphQL types of the server, as reported by introspection.
+	serverFields *serverFieldCache
+
+	// tracer creates a span for each GraphQL operation.
+	tracer trace.Tracer
+
+	// tokenSource, if not nil, provides the Authorization header in place of the one in headers.
+	tokenSource *identityTokenSource
+}
+
+// serverFieldCache holds the introspected GraphQL types of the server, keyed by type name.
+type serverFieldCache struct {
+	mu    sync.Mutex
+	types map[string]serverType
+}
+
+// serverType is the result of introspecting a GraphQL type.
+type serverType struct {
+	// known is false if the server did not report the type, in which case its fields are unknown.
+	known bool
+	// fields holds the names of the fields or input fields of the type.
+	fields map[string]bool
 }
 
-func NewGraphQLClientWithHeaders(endpoint string, headers http.Header) *GraphQLClientWithHeaders {
-	client := graphql.NewClient(endpoint)
-	client.Log = func(s string) { log.Println(s) }
+// NewGraphQLClientWithHeaders creates a client that sends requests to endpoint with headers. If
+// httpClient is nil, http.DefaultClient is used. Requests are logged to the wandb_graphql tflog
+// subsystem.
+func NewGraphQLClientWithHeaders(endpoint string, headers http.Header, httpClient *http.Client) *GraphQLClientWithHeaders {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	loggingClient := *httpClient
+	loggingClient.Transport = &loggingTransport{base: httpClient.Transport}
+
 	return &GraphQLClientWithHeaders{
-		client:  client,
-		headers: headers,
+		client:     graphql.NewClient(endpoint, graphql.WithHTTPClient(&loggingClient)),
+		headers:    headers,
+		baseURL:    strings.TrimSuffix(endpoint, "/graphql"),
+		httpClient: &loggingClient,
+		serverFields: &serverFieldCache{
+			types: map[string]serverType{},
+		},
+		tracer: defaultTracer(),
 	}
 }
 
-// Run wraps the graphql.Client's Run method to include headers.
+// defaultRequestTimeout is the maximum time a single API request can take when the provider does not
+// configure request_timeout.
+const defaultRequestTimeout = 60 * time.Second
+
+// HTTPTransportConfig configures the TLS and proxy settings of the transport used to reach the API.
+type HTTPTransportConfig struct {
+	CACertFile         string
+	ClientCertFile     string
+	ClientKeyFile      string
+	InsecureSkipVerify bool
+	ProxyURL           string
+}
+
+// NewHTTPTransport creates a transport with the settings in config, starting from the settings of
+// http.DefaultTransport. Without a proxy URL, proxies are read from the environment.
+func NewHTTPTransport(config HTTPTransportConfig) (*http.Transport, error) {
+	defaultTransport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("unexpected default transport type: %T", http.DefaultTransport)
+	}
+	transport := defaultTransport.Clone()
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	if config.CACertFile != "" {
+		pem, err := os.ReadFile(config.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", config.CACertFile)
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if (config.ClientCertFile == "") != (config.ClientKeyFile == "") {
+		return nil, fmt.Errorf("a client certificate and key must be configured together")
+	}
+	if config.ClientCertFile != "" {
+		certificate, err := tls.LoadX509KeyPair(config.ClientCertFile, config.ClientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, err
+		}
+		if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL: %s", config.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return transport, nil
+}
+
+// Run wraps the graphql.Client's Run method to include headers. Each operation is traced in its own
+// span, whose context is propagated to the server in the traceparent header.
 func (c *GraphQLClientWithHeaders) Run(ctx context.Context, req *graphql.Request, resp interface{}) error {
+	// The span is named after the operation once the request is encoded, see annotateGraphQLSpan.
+	ctx, span := c.tracer.Start(ctx, "graphql", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	if err := c.setHeaders(ctx, req.Header); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	propagation.TraceContext{}.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	if err := c.client.Run(ctx, req, resp); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	span.SetStatus(codes.Ok, "")
+	return nil
+}
+
+// setHeaders sets the headers sent with every request on header, refreshing the access token of the
+// token source if it is about to expire.
+func (c *GraphQLClientWithHeaders) setHeaders(ctx context.Context, header http.Header) error {
 	for key, values := range c.headers {
 		for _, value := range values {
-			req.Header.Set(key, strings.TrimSpace(value))
+			header.Set(key, strings.TrimSpace(value))
+		}
+	}
+	if c.tokenSource != nil {
+		authorization, err := c.tokenSource.authorization(ctx)
+		if err != nil {
+			return fmt.Errorf("refreshing W&B access token: %w", err)
+		}
+		header.Set("Authorization", authorization)
+	}
+	return nil
+}
+
+// SCIMError is returned by RunSCIM when the SCIM API responds with an error status.
+type SCIMError struct {
+	StatusCode int
+	Detail     string
+}
+
+func (e *SCIMError) Error() string {
+	if e.Detail == "" {
+		return fmt.Sprintf("scim: unexpected status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("scim: %s (status %d)", e.Detail, e.StatusCode)
+}
+
+// RunSCIM sends a request to the SCIM REST API at path, relative to the base URL, using the same
+// headers as GraphQL requests. body is encoded as JSON if not nil, and the response is decoded into
+// resp if not nil.
+func (c *GraphQLClientWithHeaders) RunSCIM(ctx context.Context, method, path string, body, resp interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+"/scim"+path, reqBody)
+	if err != nil {
+		return err
+	}
+	if err := c.setHeaders(ctx, req.Header); err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/scim+json")
+	req.Header.Set("Accept", "application/scim+json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		var scimErr struct {
+			Detail string `json:"detail"`
+		}
+		// The error body is optional, so a body that cannot be decoded only loses the detail.
+		_ = json.NewDecoder(res.Body).Decode(&scimErr)
+		return &SCIMError{StatusCode: res.StatusCode, Detail: scimErr.Detail}
+	}
+
+	if resp == nil || res.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return json.NewDecoder(res.Body).Decode(resp)
+}
+
+// instancePrivateStateKey is the private state key that records the base URL of the W&B instance
+// a resource was created on.
+const instancePrivateStateKey = "instance"
+
+// privateState is the private state of a resource, as passed to CRUD operations.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// recordInstance records the base URL of the client in the private state of a created resource.
+func (c *GraphQLClientWithHeaders) recordInstance(ctx context.Context, private privateState) diag.Diagnostics {
+	value, err := json.Marshal(c.baseURL)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Error recording W&B instance", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, instancePrivateStateKey, value)
+}
+
+// checkInstance reports an error if the private state records that the resource was created on a
+// different W&B instance than the one the client is configured for, which happens when a resource is
+// moved between provider aliases. Resources created before the instance was recorded adopt the
+// client's instance.
+func (c *GraphQLClientWithHeaders) checkInstance(ctx context.Context, private privateState) diag.Diagnostics {
+	value, diags := private.GetKey(ctx, instancePrivateStateKey)
+	if diags.HasError() {
+		return diags
+	}
+	if value == nil {
+		return c.recordInstance(ctx, private)
+	}
+
+	var baseURL string
+	if err := json.Unmarshal(value, &baseURL); err != nil {
+		diags.AddError("Error reading recorded W&B instance", err.Error())
+		return diags
+	}
+	if baseURL != c.baseURL {
+		diags.AddError(
+			"Resource belongs to a different W&B instance",
+			fmt.Sprintf("The resource was created on %s, but the provider is configured for %s. Resources cannot be moved between W&B instances: "+
+				"restore the provider configuration of the resource, or remove it from state with `terraform state rm` and import it on the new instance.", baseURL, c.baseURL),
+		)
+	}
+	return diags
+}
+
+// applyProviderDefaults sets the attributes in names that are omitted from the configuration of a
+// resource to the provider's default_entity or default_project. Only entity_name and project_name
+// have defaults.
+func (c *GraphQLClientWithHeaders) applyProviderDefaults(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, names ...string) {
+	// Nothing to default when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	for _, name := range names {
+		defaultValue, setting := c.defaultEntity, "default_entity"
+		if name == "project_name" {
+			defaultValue, setting = c.defaultProject, "default_project"
+		}
+
+		var configValue, planValue types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &configValue)...)
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root(name), &planValue)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
+		if !configValue.IsNull() || !planValue.IsUnknown() {
+			continue
+		}
+
+		if defaultValue == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing "+name,
+				fmt.Sprintf("Set %s or configure %s on the provider.", name, setting),
+			)
+			continue
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), defaultValue)...)
 	}
-	return c.client.Run(ctx, req, resp)
 }
 
-func (c *GraphQLClientWithHeaders) Log(s string) {
-	c.client.Log(s)
+// requireServerSupport reports an error if the server does not support the field fieldName of the
+// GraphQL type typeName, which the resource or data source name needs.
+func (c *GraphQLClientWithHeaders) requireServerSupport(ctx context.Context, name, typeName, fieldName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if c.supportsField(ctx, typeName, fieldName) {
+		return diags
+	}
+
+	diags.AddError(
+		"Unsupported W&B server version",
+		fmt.Sprintf("%s requires a newer W&B server, but the server does not support the %s field of %s. Upgrade the server to use it.", name, fieldName, typeName),
+	)
+
+	return diags
+}
+
+// introspectType returns the fields of the GraphQL type typeName, or its input fields if it is an
+// input type. Results are cached per client, but errors are not, so a failed introspection is retried
+// by the next call. The lock is not held during the request, so concurrent callers may introspect the
+// same type at once and store the same result.
+func (c *GraphQLClientWithHeaders) introspectType(ctx context.Context, typeName string) (serverType, error) {
+	c.serverFields.mu.Lock()
+	cached, ok := c.serverFields.types[typeName]
+	c.serverFields.mu.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	gqlReq := graphql.NewRequest(`
+		query TypeFields($typeName: String!) {
+			__type(name: $typeName) {
+				fields {
+					name
+				}
+				inputFields {
+					name
+				}
+			}
+		}
+	`)
+	gqlReq.Var("typeName", typeName)
+
+	type field struct {
+		Name string `json:"name"`
+	}
+	var result struct {
+		Type *struct {
+			Fields      []field `json:"fields"`
+			InputFields []field `json:"inputFields"`
+		} `json:"__type"`
+	}
+
+	if err := c.Run(ctx, gqlReq, &result); err != nil {
+		return serverType{}, err
+	}
+
+	introspected := serverType{}
+	if result.Type != nil {
+		introspected.known = true
+		introspected.fields = map[string]bool{}
+		for _, f := range append(result.Type.Fields, result.Type.InputFields...) {
+			introspected.fields[f.Name] = true
+		}
+	}
+
+	c.serverFields.mu.Lock()
+	c.serverFields.types[typeName] = introspected
+	c.serverFields.mu.Unlock()
+	return introspected, nil
+}
+
+// supportsField reports whether the server supports the field fieldName of the GraphQL type
+// typeName. Fields are assumed to be supported when this cannot be verified: if introspection fails
+// or the server does not report the type.
+func (c *GraphQLClientWithHeaders) supportsField(ctx context.Context, typeName, fieldName string) bool {
+	if c.serverFields == nil {
+		return true
+	}
+
+	introspected, err := c.introspectType(ctx, typeName)
+	if err != nil {
+		tflog.Warn(ctx, "Could not introspect GraphQL type, assuming the server supports all of its fields", map[string]interface{}{
+			"type":  typeName,
+			"error": err.Error(),
+		})
+		return true
+	}
+	return !introspected.known || introspected.fields[fieldName]
+}
+
+// serverFieldVersions are the oldest W&B server versions that support GraphQL fields which older
+// servers reject, keyed by type and field name. They are only used to tell users which version to
+// upgrade to, support itself is detected by introspection.
+var serverFieldVersions = map[string]string{
+	"UpsertRunQueueInput.prioritizationMode":       "0.41.0",
+	"UpsertRunQueueInput.defaultPriority":          "0.41.0",
+	"UpsertRunQueueInput.externalLinks":            "0.47.0",
+	"UpsertRunQueueInput.maxConcurrentRuns":        "0.52.0",
+	"UpsertRunQueueInput.maxConcurrentRunsPerUser": "0.52.0",
+	"UpsertRunQueueInput.access":                   "0.55.0",
+}
+
+// requireServerField reports an error on attribute if the server does not support the field
+// fieldName of the GraphQL type typeName that the attribute is sent as.
+func (c *GraphQLClientWithHeaders) requireServerField(ctx context.Context, typeName, fieldName string, attribute path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if c.supportsField(ctx, typeName, fieldName) {
+		return diags
+	}
+
+	requirement := "a newer W&B server"
+	if minimum, ok := serverFieldVersions[typeName+"."+fieldName]; ok {
+		requirement = fmt.Sprintf("W&B server %s or later", minimum)
+	}
+	diags.AddAttributeError(
+		attribute,
+		"Unsupported W&B server version",
+		fmt.Sprintf("%s requires %s, but the server does not support the %s field of %s. Upgrade the server or remove %s from the configuration.",
+			attribute, requirement, fieldName, typeName, attribute),
+	)
+
+	return diags
 }
@@ -0,0 +1,689 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+const scimTestAPIKey = "scim-test-api-key"
+
+// scimTestServer is an in-memory stand-in for the W&B SCIM API.
+type scimTestServer struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	nextID int
+	users  map[string]*SCIMUser
+	groups map[string]*SCIMGroup
+}
+
+var scimMemberFilter = regexp.MustCompile(`^members\[value eq "(.*)"\]$`)
+
+func newSCIMTestServer(t *testing.T) *scimTestServer {
+	s := &scimTestServer{
+		users:  map[string]*SCIMUser{},
+		groups: map[string]*SCIMGroup{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *scimTestServer) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Basic "+base64.StdEncoding.EncodeToString([]byte("api:"+scimTestAPIKey)) {
+		s.writeError(w, http.StatusUnauthorized, "invalid credentials")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/scim/"), "/")
+	switch {
+	case parts[0] == "Users" && len(parts) == 1 && r.Method == http.MethodPost:
+		var user SCIMUser
+		if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+			s.writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		s.nextID++
+		user.ID = fmt.Sprintf("user-%d", s.nextID)
+		s.users[user.ID] = &user
+		s.writeJSON(w, http.StatusCreated, user)
+	case parts[0] == "Users" && len(parts) == 2:
+		user, ok := s.users[parts[1]]
+		if !ok {
+			s.writeError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			s.writeJSON(w, http.StatusOK, user)
+		case http.MethodPatch:
+			var patch SCIMPatchRequest
+			if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+				s.writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			for _, op := range patch.Operations {
+				switch {
+				case op.Path == "displayName" && op.Op == "remove":
+					user.DisplayName = nil
+				case op.Path == "displayName":
+					if displayName, ok := op.Value.(string); ok {
+						user.DisplayName = &displayName
+					}
+				case op.Path == "active":
+					if active, ok := op.Value.(bool); ok {
+						user.Active = &active
+					}
+				}
+			}
+			s.writeJSON(w, http.StatusOK, user)
+		case http.MethodDelete:
+			delete(s.users, user.ID)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	case parts[0] == "Groups" && len(parts) == 1 && r.Method == http.MethodPost:
+		var group SCIMGroup
+		if err := json.NewDecoder(r.Body).Decode(&group); err != nil {
+			s.writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		s.nextID++
+		group.ID = fmt.Sprintf("group-%d", s.nextID)
+		s.groups[group.ID] = &group
+		s.writeJSON(w, http.StatusCreated, group)
+	case parts[0] == "Groups" && len(parts) == 2:
+		group, ok := s.groups[parts[1]]
+		if !ok {
+			s.writeError(w, http.StatusNotFound, "group not found")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			s.writeJSON(w, http.StatusOK, group)
+		case http.MethodPatch:
+			var patch struct {
+				Operations []struct {
+					Op    string            `json:"op"`
+					Path  string            `json:"path"`
+					Value []SCIMGroupMember `json:"value"`
+				} `json:"Operations"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+				s.writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			for _, op := range patch.Operations {
+				if op.Op == "add" {
+					group.Members = append(group.Members, op.Value...)
+					continue
+				}
+				if match := scimMemberFilter.FindStringSubmatch(op.Path); match != nil {
+					for i, member := range group.Members {
+						if member.Value == match[1] {
+							group.Members = append(group.Members[:i], group.Members[i+1:]...)
+							break
+						}
+					}
+				}
+			}
+			s.writeJSON(w, http.StatusOK, group)
+		case http.MethodDelete:
+			delete(s.groups, group.ID)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	default:
+		s.writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *scimTestServer) writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func (s *scimTestServer) writeError(w http.ResponseWriter, status int, detail string) {
+	s.writeJSON(w, status, map[string]interface{}{
+		"schemas": []string{"urn:ietf:params:scim:api:messages:2.0:Error"},
+		"detail":  detail,
+		"status":  fmt.Sprint(status),
+	})
+}
+
+// client returns a client for the stand-in, authenticated the same way the provider's Configure does.
+func (s *scimTestServer) client(apiKey string) *GraphQLClientWithHeaders {
+	headers := http.Header{}
+	headers.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("api:"+apiKey)))
+	headers.Set("Content-Type", "application/json")
+	return NewGraphQLClientWithHeaders(s.URL+"/graphql", headers, nil)
+}
+
+// providerConfig returns a provider block that points the provider at the stand-in.
+func (s *scimTestServer) providerConfig() string {
+	return fmt.Sprintf(`
+provider "wandb" {
+  base_url                    = %q
+  api_key                     = %q
+  skip_credentials_validation = true
+}
+`, s.URL, scimTestAPIKey)
+}
+
+func TestRunSCIM(t *testing.T) {
+	server := newSCIMTestServer(t)
+	client := server.client(scimTestAPIKey)
+	ctx := context.Background()
+
+	var created SCIMUser
+	err := client.RunSCIM(ctx, http.MethodPost, "/Users", SCIMUser{
+		Schemas:  []string{scimUserSchema},
+		UserName: "example",
+		Emails:   []SCIMEmail{{Value: "example@example.com", Primary: true}},
+	}, &created)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+
+	user, err := readSCIMUserHelper(ctx, created.ID, client)
+	assert.NoError(t, err)
+	assert.Equal(t, "example", user.UserName)
+
+	err = client.RunSCIM(ctx, http.MethodDelete, "/Users/"+created.ID, nil, nil)
+	assert.NoError(t, err)
+
+	_, err = readSCIMUserHelper(ctx, created.ID, client)
+	assert.ErrorIs(t, err, errSCIMUserNotFound)
+	assert.True(t, isSCIMNotFound(err))
+}
+
+func TestRunSCIM_Unauthorized(t *testing.T) {
+	server := newSCIMTestServer(t)
+	client := server.client("invalid-api-key")
+
+	_, err := readSCIMGroupHelper(context.Background(), "group-1", client)
+	var scimErr *SCIMError
+	assert.ErrorAs(t, err, &scimErr)
+	assert.Equal(t, http.StatusUnauthorized, scimErr.StatusCode)
+	assert.Equal(t, "invalid credentials", scimErr.Detail)
+}
+
+// writeTestCertificate writes a self-signed client certificate and its key as PEM files, and returns
+// their paths together with the certificate.
+func writeTestCertificate(t *testing.T) (string, string, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform-provider-wandb-test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile := writeTestFile(t, "client.crt", string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})))
+	keyFile := writeTestFile(t, "client.key", string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})))
+	return certFile, keyFile, certificate
+}
+
+func TestNewHTTPTransport(t *testing.T) {
+	clientCertFile, clientKeyFile, clientCert := writeTestCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	caCertFile := writeTestFile(t, "ca.crt", string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})))
+
+	get := func(config HTTPTransportConfig) error {
+		transport, err := NewHTTPTransport(config)
+		if err != nil {
+			return err
+		}
+		res, err := (&http.Client{Transport: transport}).Get(server.URL)
+		if err != nil {
+			return err
+		}
+		return res.Body.Close()
+	}
+
+	assert.NoError(t, get(HTTPTransportConfig{CACertFile: caCertFile, ClientCertFile: clientCertFile, ClientKeyFile: clientKeyFile}))
+	assert.NoError(t, get(HTTPTransportConfig{InsecureSkipVerify: true, ClientCertFile: clientCertFile, ClientKeyFile: clientKeyFile}))
+	assert.Error(t, get(HTTPTransportConfig{ClientCertFile: clientCertFile, ClientKeyFile: clientKeyFile}))
+	assert.Error(t, get(HTTPTransportConfig{CACertFile: caCertFile}))
+}
+
+func TestNewHTTPTransport_InvalidConfig(t *testing.T) {
+	clientCertFile, _, _ := writeTestCertificate(t)
+
+	_, err := NewHTTPTransport(HTTPTransportConfig{ClientCertFile: clientCertFile})
+	assert.EqualError(t, err, "a client certificate and key must be configured together")
+
+	_, err = NewHTTPTransport(HTTPTransportConfig{CACertFile: writeTestFile(t, "ca.crt", "not a certificate")})
+	assert.ErrorContains(t, err, "no certificates found")
+
+	_, err = NewHTTPTransport(HTTPTransportConfig{ProxyURL: "proxy.example.com"})
+	assert.EqualError(t, err, "invalid proxy URL: proxy.example.com")
+}
+
+func TestNewHTTPTransport_Proxy(t *testing.T) {
+	transport, err := NewHTTPTransport(HTTPTransportConfig{ProxyURL: "http://proxy.example.com:3128"})
+	assert.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodGet, "https://api.wandb.ai/graphql", nil)
+	assert.NoError(t, err)
+	proxyURL, err := transport.Proxy(req)
+	assert.NoError(t, err)
+	assert.Equal(t, "http://proxy.example.com:3128", proxyURL.String())
+}
+
+// testPrivateState is an in-memory private state.
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+// testResourceState returns the state of r with the given attribute values, and the other attributes
+// null.
+func testResourceState(t *testing.T, r resource.Resource, attributes map[string]tftypes.Value) tfsdk.State {
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatal("unexpected schema type")
+	}
+
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range attributes {
+		values[name] = value
+	}
+	return tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+}
+
+// testRead runs the Read method of r on state, and returns the response.
+func testRead(r resource.Resource, state tfsdk.State) resource.ReadResponse {
+	resp := resource.ReadResponse{State: state}
+	// The private state type is internal to the framework, so an empty one is allocated by reflection.
+	private := reflect.ValueOf(&resp.Private).Elem()
+	private.Set(reflect.New(private.Type().Elem()))
+	r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+	return resp
+}
+
+// testCreate runs the Create method of r on plan, a state holding the planned values, and returns the
+// response.
+func testCreate(ctx context.Context, r resource.Resource, plan tfsdk.State) resource.CreateResponse {
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+	private := reflect.ValueOf(&resp.Private).Elem()
+	private.Set(reflect.New(private.Type().Elem()))
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, &resp)
+	return resp
+}
+
+// testDelete runs the Delete method of r on state, and returns the response.
+func testDelete(r resource.Resource, state tfsdk.State) resource.DeleteResponse {
+	resp := resource.DeleteResponse{State: state}
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, &resp)
+	return resp
+}
+
+func TestCheckInstance(t *testing.T) {
+	ctx := context.Background()
+	client := NewGraphQLClientWithHeaders("https://api.wandb.ai/graphql", http.Header{}, nil)
+	private := testPrivateState{}
+
+	assert.False(t, client.recordInstance(ctx, private).HasError())
+	assert.Equal(t, `"https://api.wandb.ai"`, string(private[instancePrivateStateKey]))
+	assert.False(t, client.checkInstance(ctx, private).HasError())
+
+	other := NewGraphQLClientWithHeaders("https://wandb.example.com/graphql", http.Header{}, nil)
+	diags := other.checkInstance(ctx, private)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "created on https://api.wandb.ai")
+}
+
+func TestCheckInstance_Unrecorded(t *testing.T) {
+	ctx := context.Background()
+	client := NewGraphQLClientWithHeaders("https://wandb.example.com/graphql", http.Header{}, nil)
+	private := testPrivateState{}
+
+	assert.False(t, client.checkInstance(ctx, private).HasError())
+	assert.Equal(t, `"https://wandb.example.com"`, string(private[instancePrivateStateKey]))
+}
+
+// testModifyPlan runs applyProviderDefaults for the team secret resource with the given
+// entity_name configuration, and returns the planned entity_name.
+func testModifyPlan(t *testing.T, client *GraphQLClientWithHeaders, entityName tftypes.Value) (types.String, diag.Diagnostics) {
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	NewTeamSecretResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatal("unexpected schema type")
+	}
+
+	value := func(planned bool) tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for name, attributeType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+		values["name"] = tftypes.NewValue(tftypes.String, "EXAMPLE_SECRET")
+		values["value"] = tftypes.NewValue(tftypes.String, "value")
+		values["entity_name"] = entityName
+		if planned && entityName.IsNull() {
+			values["entity_name"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+		}
+		return tftypes.NewValue(objectType, values)
+	}
+
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: value(false)},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: value(true)},
+	}
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+	client.applyProviderDefaults(ctx, req, &resp, "entity_name")
+
+	var planned types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("entity_name"), &planned)...)
+	return planned, resp.Diagnostics
+}
+
+func TestApplyProviderDefaults(t *testing.T) {
+	client := NewGraphQLClientWithHeaders("https://api.wandb.ai/graphql", http.Header{}, nil)
+	client.defaultEntity = "default-entity"
+
+	planned, diags := testModifyPlan(t, client, tftypes.NewValue(tftypes.String, nil))
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "default-entity", planned.ValueString())
+
+	planned, diags = testModifyPlan(t, client, tftypes.NewValue(tftypes.String, "configured-entity"))
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "configured-entity", planned.ValueString())
+}
+
+func TestApplyProviderDefaults_Missing(t *testing.T) {
+	client := NewGraphQLClientWithHeaders("https://api.wandb.ai/graphql", http.Header{}, nil)
+
+	_, diags := testModifyPlan(t, client, tftypes.NewValue(tftypes.String, nil))
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Set entity_name or configure default_entity on the provider.", diags.Errors()[0].Detail())
+}
+
+func newViewerTestServer(t *testing.T, response string) *GraphQLClientWithHeaders {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+	return NewGraphQLClientWithHeaders(server.URL+"/graphql", http.Header{}, nil)
+}
+
+func TestReadViewerHelper(t *testing.T) {
+	client := newViewerTestServer(t, `{"data": {
+		"viewer": {"id": "VXNlcjox", "username": "example", "email": "example@example.com", "admin": true},
+		"serverInfo": {"latestLocalVersionInfo": {"versionOnThisInstanceString": "0.62.1"}}
+	}}`)
+
+	viewer, serverVersion, err := readViewerHelper(context.Background(), client)
+	assert.NoError(t, err)
+	assert.Equal(t, "example", viewer.Username)
+	assert.Equal(t, "0.62.1", serverVersion)
+}
+
+func TestReadViewerHelper_Cloud(t *testing.T) {
+	client := newViewerTestServer(t, `{"data": {
+		"viewer": {"id": "VXNlcjox", "username": "example"},
+		"serverInfo": {"latestLocalVersionInfo": null}
+	}}`)
+
+	_, serverVersion, err := readViewerHelper(context.Background(), client)
+	assert.NoError(t, err)
+	assert.Equal(t, "", serverVersion)
+}
+
+func TestReadViewerHelper_Unauthenticated(t *testing.T) {
+	client := newViewerTestServer(t, `{"data": {"viewer": null, "serverInfo": null}}`)
+
+	_, _, err := readViewerHelper(context.Background(), client)
+	assert.Error(t, err)
+}
+
+// newIntrospectionTestServer returns a client for a GraphQL server whose types have the given
+// fields, and records the queries the server receives.
+func newIntrospectionTestServer(t *testing.T, typeFields map[string][]string, response string) (*GraphQLClientWithHeaders, *[]string) {
+	var mu sync.Mutex
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("invalid request body: %v", err)
+		}
+		mu.Lock()
+		queries = append(queries, body.Query)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if !strings.Contains(body.Query, "__type(") {
+			_, _ = w.Write([]byte(response))
+			return
+		}
+		typeName, _ := body.Variables["typeName"].(string)
+		fields := []map[string]string{}
+		for _, name := range typeFields[typeName] {
+			fields = append(fields, map[string]string{"name": name})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"__type": map[string]interface{}{"fields": fields, "inputFields": nil},
+			},
+		})
+	}))
+	t.Cleanup(server.Close)
+	return NewGraphQLClientWithHeaders(server.URL+"/graphql", http.Header{}, nil), &queries
+}
+
+func TestSupportsField(t *testing.T) {
+	client, queries := newIntrospectionTestServer(t, map[string][]string{
+		"RunQueue": {"id", "name", "prioritizationMode"},
+	}, "")
+
+	ctx := context.Background()
+	assert.True(t, client.supportsField(ctx, "RunQueue", "prioritizationMode"))
+	assert.False(t, client.supportsField(ctx, "RunQueue", "externalLinks"))
+	assert.Len(t, *queries, 1, "introspection results are cached")
+}
+
+func TestSupportsField_IntrospectionDisabled(t *testing.T) {
+	client := newViewerTestServer(t, `{"errors": [{"message": "introspection is disabled"}]}`)
+
+	assert.True(t, client.supportsField(context.Background(), "RunQueue", "externalLinks"))
+}
+
+func TestSupportsField_TransientError(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"__type": {"fields": [{"name": "prioritizationMode"}], "inputFields": null}}}`))
+	}))
+	t.Cleanup(server.Close)
+	client := NewGraphQLClientWithHeaders(server.URL+"/graphql", http.Header{}, nil)
+
+	ctx := context.Background()
+	assert.True(t, client.supportsField(ctx, "RunQueue", "externalLinks"), "fields are assumed supported when introspection fails")
+	assert.False(t, client.supportsField(ctx, "RunQueue", "externalLinks"), "failed introspection is retried")
+	assert.True(t, client.supportsField(ctx, "RunQueue", "prioritizationMode"))
+	assert.Equal(t, 2, requests)
+}
+
+func TestSupportsField_UnknownType(t *testing.T) {
+	client := newViewerTestServer(t, `{"data": {"__type": null}}`)
+
+	assert.True(t, client.supportsField(context.Background(), "RunQueue", "externalLinks"))
+}
+
+func TestRequireServerField(t *testing.T) {
+	client, _ := newIntrospectionTestServer(t, map[string][]string{
+		"UpsertRunQueueInput": {"queueName", "prioritizationMode"},
+	}, "")
+
+	ctx := context.Background()
+	assert.False(t, client.requireServerField(ctx, "UpsertRunQueueInput", "prioritizationMode", path.Root("prioritization_mode")).HasError())
+
+	diags := client.requireServerField(ctx, "UpsertRunQueueInput", "access", path.Root("access"))
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "access requires W&B server 0.55.0 or later")
+}
+
+func TestRequireServerSupport(t *testing.T) {
+	client, _ := newIntrospectionTestServer(t, map[string][]string{
+		"Mutation": {"createCustomRole"},
+	}, "")
+
+	ctx := context.Background()
+	assert.False(t, client.requireServerSupport(ctx, "wandb_custom_role", "Mutation", "createCustomRole").HasError())
+
+	diags := client.requireServerSupport(ctx, "wandb_automation", "Mutation", "createFilterTrigger")
+	if assert.True(t, diags.HasError()) {
+		assert.Equal(t, "wandb_automation requires a newer W&B server, but the server does not support the createFilterTrigger field of Mutation. Upgrade the server to use it.", diags.Errors()[0].Detail())
+	}
+}
+
+func TestReadRunQueueHelper_UnsupportedFields(t *testing.T) {
+	client, queries := newIntrospectionTestServer(t, map[string][]string{
+		"RunQueue": {"id", "name", "entityName", "defaultResourceConfig", "prioritizationMode", "defaultPriority", "createdAt", "updatedAt"},
+	}, `{"data": {"project": {"runQueue": {"id": "UnVuUXVldWU6MQ==", "name": "example", "entityName": "example", "prioritizationMode": "V0"}}}}`)
+
+	runQueue, err := readRunQueueHelper("example", "example", context.Background(), *client)
+	assert.NoError(t, err)
+	assert.Equal(t, "V0", runQueue.PrioritizationMode)
+
+	query := (*queries)[len(*queries)-1]
+	assert.Contains(t, query, "prioritizationMode")
+	assert.NotContains(t, query, "externalLinks")
+	assert.NotContains(t, query, "access")
+	assert.NotContains(t, query, "maxConcurrentRuns")
+}
+
+func TestUpsertRunQueue_UnsupportedFields(t *testing.T) {
+	client, queries := newIntrospectionTestServer(t, map[string][]string{
+		"UpsertRunQueueInput": {"entityName", "projectName", "queueName", "resourceType", "resourceConfig", "templateVariables", "prioritizationMode"},
+	}, `{"data": {"upsertRunQueue": {"success": true, "configSchemaValidationErrors": []}}}`)
+
+	prioritizationMode := "V0"
+	result, err := upsertRunQueue(context.Background(), UpsertRunQueueInput{
+		QueueName:          "example",
+		EntityName:         "example",
+		ResourceType:       "kubernetes",
+		ResourceConfig:     "{}",
+		PrioritizationMode: &prioritizationMode,
+	}, client)
+	assert.NoError(t, err)
+	assert.True(t, result.UpsertRunQueue.Success)
+
+	mutation := (*queries)[len(*queries)-1]
+	assert.Contains(t, mutation, "prioritizationMode: $prioritizationMode")
+	assert.NotContains(t, mutation, "externalLinks")
+	assert.NotContains(t, mutation, "$access")
+}
+
+func TestRunQueueModifyPlan_UnsupportedFields(t *testing.T) {
+	client, _ := newIntrospectionTestServer(t, map[string][]string{
+		"UpsertRunQueueInput": {"entityName", "queueName", "prioritizationMode"},
+	}, "")
+
+	ctx := context.Background()
+	r := &RunQueueResource{client: client}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatal("unexpected schema type")
+	}
+
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["name"] = tftypes.NewValue(tftypes.String, "example")
+	values["entity_name"] = tftypes.NewValue(tftypes.String, "example")
+	values["resource"] = tftypes.NewValue(tftypes.String, "kubernetes")
+	values["prioritization_mode"] = tftypes.NewValue(tftypes.String, "V0")
+	values["max_concurrent_runs"] = tftypes.NewValue(tftypes.Number, 2)
+	value := tftypes.NewValue(objectType, values)
+
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: value},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: value},
+	}
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, &resp)
+
+	assert.Len(t, resp.Diagnostics.Errors(), 1)
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "max_concurrent_runs requires W&B server 0.52.0 or later")
+}
@@ -0,0 +1,442 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/machinebox/graphql"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CustomRoleResource{}
+var _ resource.ResourceWithConfigure = &CustomRoleResource{}
+var _ resource.ResourceWithImportState = &CustomRoleResource{}
+var _ resource.ResourceWithValidateConfig = &CustomRoleResource{}
+
+func NewCustomRoleResource() resource.Resource {
+	return &CustomRoleResource{}
+}
+
+type CustomRoleResource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type CustomRoleResourceModel struct {
+	Id               types.String   `tfsdk:"id"`
+	RoleId           types.String   `tfsdk:"role_id"`
+	OrganizationName types.String   `tfsdk:"organization_name"`
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	BaseRole         types.String   `tfsdk:"base_role"`
+	Permissions      types.Set      `tfsdk:"permissions"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *CustomRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "wandb_custom_role"
+}
+
+func (r *CustomRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Custom role resource. A custom role inherits the permissions of a base role and grants additional permissions on top of it, for example a viewer that can also launch runs. The permissions that can be granted are listed by the wandb_role_permissions data source. See [here](https://github.com/wandb/terraform-provider-wandb/blob/main/examples/resources/custom_role/resource.tf) for an example",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the custom role resource. This is a composite ID of the organization name and the role ID, separated by a ':'",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the custom role, used to assign the role to team members.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the organization that the custom role belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the custom role.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "A description of the custom role.",
+			},
+			"base_role": schema.StringAttribute{
+				Required:    true,
+				Description: "The role whose permissions the custom role inherits. Options include: viewer and member.",
+			},
+			"permissions": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The names of the permissions granted in addition to those of the base role.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *CustomRoleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CustomRoleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.BaseRole.IsNull() || data.BaseRole.IsUnknown() {
+		return
+	}
+
+	if _, ok := customRoleBaseRoles[data.BaseRole.ValueString()]; !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_role"),
+			"Invalid base role",
+			"base_role must be one of: viewer, member.",
+		)
+	}
+}
+
+func (r *CustomRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(client.requireServerSupport(ctx, "wandb_custom_role", "Mutation", "createCustomRole")...)
+	r.client = client
+}
+
+func (r *CustomRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CustomRoleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var permissions []string
+	resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, err := readOrganizationHelper(ctx, data.OrganizationName.ValueString(), r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading organization",
+			"Could not read organization, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation CreateCustomRole(
+			$organizationId: ID!,
+			$name: String!,
+			$description: String,
+			$inheritedFrom: String!,
+			$permissions: [String!]!,
+		) {
+			createCustomRole(input: {
+				organizationId: $organizationId,
+				name: $name,
+				description: $description,
+				inheritedFrom: $inheritedFrom,
+				permissions: $permissions,
+			}) {
+				role {
+					id
+				}
+			}
+		}
+	`)
+	gqlReq.Var("organizationId", organization.ID)
+	gqlReq.Var("name", data.Name.ValueString())
+	gqlReq.Var("description", data.Description.ValueStringPointer())
+	gqlReq.Var("inheritedFrom", customRoleBaseRoles[data.BaseRole.ValueString()])
+	gqlReq.Var("permissions", permissions)
+
+	var result struct {
+		CreateCustomRole struct {
+			Role *struct {
+				ID string `json:"id"`
+			} `json:"role"`
+		} `json:"createCustomRole"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating custom role",
+			"Could not create custom role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if result.CreateCustomRole.Role == nil {
+		resp.Diagnostics.AddError(
+			"Failed to create custom role",
+			"The API did not return the created custom role.",
+		)
+		return
+	}
+
+	roleID := result.CreateCustomRole.Role.ID
+	data.RoleId = types.StringValue(roleID)
+	data.Id = types.StringValue(generateCompositeID(data.OrganizationName.ValueString(), roleID))
+
+	tflog.Trace(ctx, "created a custom role resource")
+
+	resp.Diagnostics.Append(r.client.recordInstance(ctx, resp.Private)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CustomRoleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkInstance(ctx, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	organizationName, roleID, err := parseCompositeID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing composite ID", err.Error())
+		return
+	}
+
+	role, err := readCustomRoleHelper(ctx, organizationName, roleID, r.client)
+	if errors.Is(err, errCustomRoleNotFound) {
+		tflog.Warn(ctx, "custom role no longer exists, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading custom role",
+			"Could not read custom role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if role.InheritedFrom == nil {
+		resp.Diagnostics.AddError("Error reading base role", "The custom role does not inherit from a base role.")
+		return
+	}
+	baseRole, err := customRoleBaseRoleFromAPI(role.InheritedFrom.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading base role", err.Error())
+		return
+	}
+
+	permissions := make([]string, 0, len(role.Permissions))
+	for _, permission := range role.Permissions {
+		permissions = append(permissions, permission.Name)
+	}
+	permissionSet, diags := types.SetValueFrom(ctx, types.StringType, permissions)
+	resp.Diagnostics.Append(diags...)
+
+	data.RoleId = types.StringValue(role.ID)
+	data.OrganizationName = types.StringValue(organizationName)
+	data.Name = types.StringValue(role.Name)
+	data.Description = stringPointerValueOrNull(role.Description)
+	data.BaseRole = types.StringValue(baseRole)
+	data.Permissions = permissionSet
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CustomRoleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var permissions []string
+	resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation UpdateCustomRole(
+			$roleId: ID!,
+			$name: String!,
+			$description: String,
+			$inheritedFrom: String!,
+			$permissions: [String!]!,
+		) {
+			updateCustomRole(input: {
+				roleId: $roleId,
+				name: $name,
+				description: $description,
+				inheritedFrom: $inheritedFrom,
+				permissions: $permissions,
+			}) {
+				role {
+					id
+				}
+			}
+		}
+	`)
+	gqlReq.Var("roleId", data.RoleId.ValueString())
+	gqlReq.Var("name", data.Name.ValueString())
+	gqlReq.Var("description", data.Description.ValueStringPointer())
+	gqlReq.Var("inheritedFrom", customRoleBaseRoles[data.BaseRole.ValueString()])
+	gqlReq.Var("permissions", permissions)
+
+	var result struct {
+		UpdateCustomRole struct {
+			Role *struct {
+				ID string `json:"id"`
+			} `json:"role"`
+		} `json:"updateCustomRole"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating custom role",
+			"Could not update custom role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if result.UpdateCustomRole.Role == nil {
+		resp.Diagnostics.AddError(
+			"Failed to update custom role",
+			"The API did not confirm the update of the custom role.",
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CustomRoleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation DeleteCustomRole($roleId: ID!) {
+			deleteCustomRole(input: {roleId: $roleId}) {
+				success
+			}
+		}
+	`)
+	gqlReq.Var("roleId", data.RoleId.ValueString())
+
+	var result struct {
+		DeleteCustomRole struct {
+			Success bool `json:"success"`
+		} `json:"deleteCustomRole"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting custom role",
+			"Could not delete custom role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !result.DeleteCustomRole.Success {
+		resp.Diagnostics.AddError(
+			"Failed to delete custom role",
+			"The API did not confirm the deletion of the custom role.",
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a custom role resource")
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *CustomRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
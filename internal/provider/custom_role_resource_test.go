@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccCustomRoleResource(t *testing.T) {
+	resourceName := "wandb_custom_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckCustomRoleResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoleResourceConfig("viewer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "role_id"),
+					resource.TestCheckResourceAttr(resourceName, "base_role", "viewer"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCustomRoleResourceConfig("member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "base_role", "member"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCustomRoleResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "wandb_custom_role" {
+			continue
+		}
+
+		client := newGraphQLClient()
+
+		_, err := readCustomRoleHelper(context.Background(), rs.Primary.Attributes["organization_name"], rs.Primary.Attributes["role_id"], client)
+		if err == nil {
+			return fmt.Errorf("custom role still exists: %s", rs.Primary.ID)
+		}
+		if !errors.Is(err, errCustomRoleNotFound) {
+			return fmt.Errorf("checking that custom role %s was destroyed: %w", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCustomRoleResourceConfig(baseRole string) string {
+	return fmt.Sprintf(`
+data "wandb_role_permissions" "test" {
+  organization_name = "terraform-acceptance-test"
+}
+
+resource "wandb_custom_role" "test" {
+  organization_name = "terraform-acceptance-test"
+  name              = "terraform-example"
+  description       = "Custom role managed by the acceptance tests"
+  base_role         = %q
+  permissions       = [data.wandb_role_permissions.test.permissions[0].name]
+}
+`, baseRole)
+}
+
+func TestCustomRoleResourceRead_NotFound(t *testing.T) {
+	client, _ := newIntrospectionTestServer(t, nil, `{"data": {"organization": {"roles": []}}}`)
+	r := &CustomRoleResource{client: client}
+
+	resp := testRead(r, testResourceState(t, r, map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, "example-organization:role-1"),
+		"role_id":           tftypes.NewValue(tftypes.String, "role-1"),
+		"organization_name": tftypes.NewValue(tftypes.String, "example-organization"),
+		"name":              tftypes.NewValue(tftypes.String, "example"),
+	}))
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
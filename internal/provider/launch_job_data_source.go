@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LaunchJobDataSource{}
+var _ datasource.DataSourceWithConfigure = &LaunchJobDataSource{}
+
+func NewLaunchJobDataSource() datasource.DataSource {
+	return &LaunchJobDataSource{}
+}
+
+type LaunchJobDataSource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type LaunchJobDataSourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Job          types.String `tfsdk:"job"`
+	Version      types.String `tfsdk:"version"`
+	VersionIndex types.Int64  `tfsdk:"version_index"`
+	Digest       types.String `tfsdk:"digest"`
+	SourceType   types.String `tfsdk:"source_type"`
+	InputSchema  types.String `tfsdk:"input_schema"`
+	VersionedJob types.String `tfsdk:"versioned_job"`
+}
+
+func (d *LaunchJobDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "wandb_launch_job"
+}
+
+func (d *LaunchJobDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resolves a W&B Launch job, such as `entity/project/job-name:latest`, to a pinned version so automations and sweeps launch a known job.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the job artifact version.",
+			},
+			"job": schema.StringAttribute{
+				Required:    true,
+				Description: "The job to resolve, in the form entity/project/job-name:alias.",
+			},
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The version the alias resolves to, for example 'v3'.",
+			},
+			"version_index": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The numeric index of the version within the job.",
+			},
+			"digest": schema.StringAttribute{
+				Computed:    true,
+				Description: "The content digest of the job version.",
+			},
+			"source_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The source the job runs from. One of: image, git and code_artifact. Unset if the job does not record its source.",
+			},
+			"input_schema": schema.StringAttribute{
+				Computed:    true,
+				Description: "The schema of the job's inputs as a JSON string. Unset if the job does not record its inputs.",
+			},
+			"versioned_job": schema.StringAttribute{
+				Computed:    true,
+				Description: "The job pinned to its resolved version, in the form entity/project/job-name:v3.",
+			},
+		},
+	}
+}
+
+func (d *LaunchJobDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *LaunchJobDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data LaunchJobDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobPath, err := parseArtifactPath(data.Job.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing job path", err.Error())
+		return
+	}
+
+	artifact, err := readArtifactHelper(ctx, jobPath, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading job",
+			"Could not read job "+jobPath.String()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if artifact.ArtifactType.Name != "job" {
+		resp.Diagnostics.AddError(
+			"Error reading job",
+			"Artifact "+jobPath.String()+" is of type '"+artifact.ArtifactType.Name+"', not a launch job.",
+		)
+		return
+	}
+
+	if artifact.VersionIndex == nil {
+		resp.Diagnostics.AddError(
+			"Error reading job",
+			"Job "+jobPath.String()+" has not been committed and has no version.",
+		)
+		return
+	}
+
+	sourceType, inputSchema, err := parseLaunchJobMetadata(artifact.Metadata)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading job metadata",
+			"Could not parse metadata of job "+jobPath.String()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	versionedPath := jobPath
+	versionedPath.Version = fmt.Sprintf("v%d", *artifact.VersionIndex)
+
+	data.Id = types.StringValue(artifact.ID)
+	data.Version = types.StringValue(versionedPath.Version)
+	data.VersionIndex = types.Int64Value(int64(*artifact.VersionIndex))
+	data.Digest = types.StringValue(artifact.Digest)
+	data.SourceType = types.StringPointerValue(sourceType)
+	data.InputSchema = types.StringPointerValue(inputSchema)
+	data.VersionedJob = types.StringValue(versionedPath.String())
+
+	tflog.Trace(ctx, "read a launch job data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
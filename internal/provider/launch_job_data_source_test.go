@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLaunchJobDataSource(t *testing.T) {
+	dataSourceName := "data.wandb_launch_job.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchJobDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "version", "v0"),
+					resource.TestCheckResourceAttr(dataSourceName, "versioned_job", "terraform-acceptance-test/launch/job-example:v0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "digest"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "source_type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLaunchJobDataSourceConfig() string {
+	return `
+data "wandb_launch_job" "test" {
+  job = "terraform-acceptance-test/launch/job-example:v0"
+}
+`
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// graphQLLogSubsystem is the tflog subsystem API requests are logged to. Its level can be set
+// separately from the provider's with the TF_LOG_PROVIDER_WANDB_GRAPHQL environment variable.
+const graphQLLogSubsystem = "wandb_graphql"
+
+// redactedValue replaces secrets in logged requests.
+const redactedValue = "***"
+
+// secretVariableNames are the names of GraphQL variables, input fields and JSON body fields, in lower
+// case, whose values are secrets. Run queue resource configs and run specs are redacted as a whole,
+// because they can hold credentials anywhere in their JSON. Webhook URLs are redacted because they
+// often embed a token, and so are the secret references sent with them.
+var secretVariableNames = map[string]bool{
+	"accesstoken":            true,
+	"accesstokenref":         true,
+	"apikey":                 true,
+	"clientsecret":           true,
+	"code":                   true,
+	"overrides":              true,
+	"password":               true,
+	"resourceconfig":         true,
+	"runspec":                true,
+	"secretref":              true,
+	"secretvalue":            true,
+	"signingsecret":          true,
+	"templatevariablevalues": true,
+	"token":                  true,
+	"urlendpoint":            true,
+}
+
+// secretHeaderPattern matches the names of headers whose values are credentials, including extra
+// headers configured on the provider to carry API keys or tokens.
+var secretHeaderPattern = regexp.MustCompile(`(?i)auth|cookie|key|secret|token`)
+
+// graphQLOperationPattern matches the operation type and name at the start of a GraphQL document.
+var graphQLOperationPattern = regexp.MustCompile(`^\s*(query|mutation|subscription)\s+([_A-Za-z][_0-9A-Za-z]*)`)
+
+// loggingTransport logs the requests sent to the W&B API to the wandb_graphql subsystem: the
+// operation and latency at DEBUG, and the variables or JSON body and headers at TRACE, with secrets
+// redacted. It also records the operation and response status on the request's trace span, if any.
+type loggingTransport struct {
+	base http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), graphQLLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER", graphQLLogSubsystem))
+
+	operation := req.Method + " " + req.URL.Path
+	fields := map[string]interface{}{
+		"headers": redactHeaders(req.Header),
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		if strings.HasSuffix(req.URL.Path, "/graphql") {
+			var graphQLRequest struct {
+				Query     string                 `json:"query"`
+				Variables map[string]interface{} `json:"variables"`
+			}
+			// Bodies that are not GraphQL requests are sent as they are, and logged without variables.
+			if json.Unmarshal(body, &graphQLRequest) == nil {
+				operationType, operationName := parseGraphQLOperation(graphQLRequest.Query)
+				operation = graphQLOperationName(graphQLRequest.Query)
+				fields["variables"] = redactVariables(graphQLRequest.Variables)
+				annotateGraphQLSpan(req.Context(), operationType, operationName, graphQLRequest.Variables)
+			}
+		} else {
+			// Other JSON bodies, such as SCIM requests, are logged with the same fields redacted. Bodies
+			// that are not JSON objects, such as token exchange forms, are not logged.
+			var jsonBody map[string]interface{}
+			if json.Unmarshal(body, &jsonBody) == nil {
+				fields["body"] = redactVariables(jsonBody)
+			}
+		}
+	}
+	ctx = tflog.SubsystemSetField(ctx, graphQLLogSubsystem, "operation", operation)
+
+	tflog.SubsystemTrace(ctx, graphQLLogSubsystem, "Sending request to the W&B API", fields)
+
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	start := time.Now()
+	res, err := base.RoundTrip(req)
+	latency := time.Since(start)
+
+	if err != nil {
+		tflog.SubsystemDebug(ctx, graphQLLogSubsystem, "Request to the W&B API failed", map[string]interface{}{
+			"latency_ms": latency.Milliseconds(),
+			"error":      err.Error(),
+		})
+		return nil, err
+	}
+	trace.SpanFromContext(req.Context()).SetAttributes(semconv.HTTPResponseStatusCode(res.StatusCode))
+	tflog.SubsystemDebug(ctx, graphQLLogSubsystem, "Received response from the W&B API", map[string]interface{}{
+		"latency_ms":  latency.Milliseconds(),
+		"status_code": res.StatusCode,
+	})
+	return res, nil
+}
+
+// graphQLOperationName returns the type and name of the operation in query, such as
+// "mutation UpsertRunQueue".
+func graphQLOperationName(query string) string {
+	operationType, operationName := parseGraphQLOperation(query)
+	if operationName == "" {
+		return "anonymous operation"
+	}
+	return operationType + " " + operationName
+}
+
+// parseGraphQLOperation returns the type and name of the operation in query. Anonymous operations
+// have an empty name, and the shorthand syntax without a type is a query.
+func parseGraphQLOperation(query string) (string, string) {
+	match := graphQLOperationPattern.FindStringSubmatch(query)
+	if match == nil {
+		return "query", ""
+	}
+	return match[1], match[2]
+}
+
+// redactHeaders returns the values of header, with the values of credential headers redacted.
+func redactHeaders(header http.Header) map[string]string {
+	redacted := make(map[string]string, len(header))
+	for name := range header {
+		if secretHeaderPattern.MatchString(name) {
+			redacted[name] = redactedValue
+			continue
+		}
+		redacted[name] = header.Get(name)
+	}
+	return redacted
+}
+
+// redactVariables returns a copy of the GraphQL variables, with the values of secret variables and
+// input fields redacted at any depth.
+func redactVariables(variables map[string]interface{}) map[string]interface{} {
+	redacted := make(map[string]interface{}, len(variables))
+	for name, value := range variables {
+		if secretVariableNames[strings.ToLower(name)] && value != nil {
+			redacted[name] = redactedValue
+			continue
+		}
+		redacted[name] = redactValue(value)
+	}
+	return redacted
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return redactVariables(v)
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, element := range v {
+			redacted[i] = redactValue(element)
+		}
+		return redacted
+	default:
+		return value
+	}
+}
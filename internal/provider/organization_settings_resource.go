@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/machinebox/graphql"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OrganizationSettingsResource{}
+var _ resource.ResourceWithConfigure = &OrganizationSettingsResource{}
+var _ resource.ResourceWithImportState = &OrganizationSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &OrganizationSettingsResource{}
+
+func NewOrganizationSettingsResource() resource.Resource {
+	return &OrganizationSettingsResource{}
+}
+
+type OrganizationSettingsResource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type OrganizationSettingsResourceModel struct {
+	Id                  types.String   `tfsdk:"id"`
+	OrganizationName    types.String   `tfsdk:"organization_name"`
+	DefaultTeamPrivacy  types.String   `tfsdk:"default_team_privacy"`
+	AllowedEmailDomains types.Set      `tfsdk:"allowed_email_domains"`
+	CodeSavingEnabled   types.Bool     `tfsdk:"code_saving_enabled"`
+	HidePublicProjects  types.Bool     `tfsdk:"hide_public_projects"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *OrganizationSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "wandb_organization_settings"
+}
+
+func (r *OrganizationSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Organization settings resource. Each organization has exactly one set of settings, so this resource manages existing settings rather than creating them: only the attributes that are set are updated, and the rest are read from the organization. Destroying the resource leaves the settings unchanged. See [here](https://github.com/wandb/terraform-provider-wandb/blob/main/examples/resources/organization_settings/resource.tf) for an example",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"default_team_privacy": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The default privacy of new teams. Options include: private and public.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allowed_email_domains": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The email domains users must sign up with to join the organization. An empty set allows any domain.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"code_saving_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether code saving is enabled by default for new teams and users.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hide_public_projects": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether public projects are hidden from users outside the organization.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *OrganizationSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data OrganizationSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DefaultTeamPrivacy.IsNull() || data.DefaultTeamPrivacy.IsUnknown() {
+		return
+	}
+
+	if privacy := data.DefaultTeamPrivacy.ValueString(); privacy != "private" && privacy != "public" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_team_privacy"),
+			"Invalid default team privacy",
+			"default_team_privacy must be one of: private, public.",
+		)
+	}
+}
+
+func (r *OrganizationSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, err := readOrganizationHelper(ctx, data.OrganizationName.ValueString(), r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading organization",
+			"Could not read organization, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	data.Id = types.StringValue(organization.ID)
+
+	resp.Diagnostics.Append(r.updateOrganizationSettings(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created an organization settings resource")
+
+	resp.Diagnostics.Append(r.client.recordInstance(ctx, resp.Private)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkInstance(ctx, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, err := readOrganizationHelper(ctx, data.OrganizationName.ValueString(), r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading organization settings",
+			"Could not read organization settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setOrganizationSettingsModel(ctx, &data, organization)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OrganizationSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateOrganizationSettings(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings belong to the organization and cannot be deleted, so they are only removed from state.
+	tflog.Trace(ctx, "deleted an organization settings resource")
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *OrganizationSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("organization_name"), req, resp)
+}
+
+// updateOrganizationSettings sends the settings that are set in the plan, leaving the others unchanged,
+// and then refreshes the model with the resulting settings.
+func (r *OrganizationSettingsResource) updateOrganizationSettings(ctx context.Context, data *OrganizationSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	gqlReq := graphql.NewRequest(`
+		mutation UpdateOrganizationSettings(
+			$organizationID: ID!,
+			$defaultTeamPrivacy: String,
+			$allowedEmailDomains: [String!],
+			$codeSavingEnabled: Boolean,
+			$hidePublicProjects: Boolean,
+		) {
+			updateOrganizationSettings(input: {
+				organizationID: $organizationID,
+				defaultTeamPrivacy: $defaultTeamPrivacy,
+				allowedEmailDomains: $allowedEmailDomains,
+				codeSavingEnabled: $codeSavingEnabled,
+				hidePublicProjects: $hidePublicProjects,
+			}) {
+				organization {
+					id
+				}
+			}
+		}
+	`)
+	gqlReq.Var("organizationID", data.Id.ValueString())
+
+	if !data.DefaultTeamPrivacy.IsUnknown() && !data.DefaultTeamPrivacy.IsNull() {
+		gqlReq.Var("defaultTeamPrivacy", strings.ToUpper(data.DefaultTeamPrivacy.ValueString()))
+	}
+	if !data.AllowedEmailDomains.IsUnknown() && !data.AllowedEmailDomains.IsNull() {
+		domains := []string{}
+		diags.Append(data.AllowedEmailDomains.ElementsAs(ctx, &domains, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		gqlReq.Var("allowedEmailDomains", domains)
+	}
+	if !data.CodeSavingEnabled.IsUnknown() && !data.CodeSavingEnabled.IsNull() {
+		gqlReq.Var("codeSavingEnabled", data.CodeSavingEnabled.ValueBool())
+	}
+	if !data.HidePublicProjects.IsUnknown() && !data.HidePublicProjects.IsNull() {
+		gqlReq.Var("hidePublicProjects", data.HidePublicProjects.ValueBool())
+	}
+
+	var result struct {
+		UpdateOrganizationSettings struct {
+			Organization *struct {
+				ID string `json:"id"`
+			} `json:"organization"`
+		} `json:"updateOrganizationSettings"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		diags.AddError(
+			"Error updating organization settings",
+			"Could not update organization settings, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	if result.UpdateOrganizationSettings.Organization == nil {
+		diags.AddError(
+			"Failed to update organization settings",
+			"The API did not confirm the update of the organization settings.",
+		)
+		return diags
+	}
+
+	// Settings that were not configured are computed, so they are filled in from the organization.
+	organization, err := readOrganizationHelper(ctx, data.OrganizationName.ValueString(), r.client)
+	if err != nil {
+		diags.AddError(
+			"Error reading organization settings",
+			"Could not read organization settings, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	diags.Append(setOrganizationSettingsModel(ctx, data, organization)...)
+
+	return diags
+}
+
+func setOrganizationSettingsModel(ctx context.Context, data *OrganizationSettingsResourceModel, organization *Organization) diag.Diagnostics {
+	allowedEmailDomains := organization.Settings.AllowedEmailDomains
+	if allowedEmailDomains == nil {
+		allowedEmailDomains = []string{}
+	}
+	domains, diags := types.SetValueFrom(ctx, types.StringType, allowedEmailDomains)
+
+	data.Id = types.StringValue(organization.ID)
+	data.OrganizationName = types.StringValue(organization.Name)
+	data.DefaultTeamPrivacy = types.StringValue(strings.ToLower(organization.Settings.DefaultTeamPrivacy))
+	data.AllowedEmailDomains = domains
+	data.CodeSavingEnabled = types.BoolValue(organization.Settings.CodeSavingEnabled)
+	data.HidePublicProjects = types.BoolValue(organization.Settings.HidePublicProjects)
+
+	return diags
+}
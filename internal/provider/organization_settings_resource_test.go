@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationSettingsResource(t *testing.T) {
+	resourceName := "wandb_organization_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationSettingsResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "hide_public_projects", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "default_team_privacy"),
+					resource.TestCheckResourceAttrSet(resourceName, "code_saving_enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "terraform-acceptance-test",
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOrganizationSettingsResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "hide_public_projects", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationSettingsResourceConfig(hidePublicProjects bool) string {
+	return fmt.Sprintf(`
+resource "wandb_organization_settings" "test" {
+  organization_name    = "terraform-acceptance-test"
+  hide_public_projects = %t
+}
+`, hidePublicProjects)
+}
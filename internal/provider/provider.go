@@ -5,17 +5,21 @@ package provider
 
 import (
 	"context"
-	"encoding/base64"
+	"fmt"
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure WandbLaunchProvider satisfies various provider interfaces.
@@ -32,8 +36,21 @@ type WandbLaunchProvider struct {
 
 // WandbLaunchProviderModel describes the provider data model.
 type WandbLaunchProviderModel struct {
-	BaseUrl types.String `tfsdk:"base_url"`
-	ApiKey  types.String `tfsdk:"api_key"`
+	BaseUrl            types.String `tfsdk:"base_url"`
+	ApiKey             types.String `tfsdk:"api_key"`
+	ApiKeyFile         types.String `tfsdk:"api_key_file"`
+	IdentityTokenFile  types.String `tfsdk:"identity_token_file"`
+	ExtraHeaders       types.Map    `tfsdk:"extra_headers"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	ClientCertFile     types.String `tfsdk:"client_cert_file"`
+	ClientKeyFile      types.String `tfsdk:"client_key_file"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyUrl           types.String `tfsdk:"proxy_url"`
+	DefaultEntity      types.String `tfsdk:"default_entity"`
+	DefaultProject     types.String `tfsdk:"default_project"`
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
+
+	SkipCredentialsValidation types.Bool `tfsdk:"skip_credentials_validation"`
 }
 
 func New(version string) func() provider.Provider {
@@ -61,6 +78,55 @@ func (p *WandbLaunchProvider) Schema(ctx context.Context, req provider.SchemaReq
 				Sensitive:   true,
 				Description: "The API key for the W&B API. Defaults to WANDB_API_KEY environment variable.",
 			},
+			"api_key_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path of a file containing the API key for the W&B API. Takes precedence over the WANDB_API_KEY environment variable.",
+			},
+			"identity_token_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path of a file containing a workload identity token (JWT), which is exchanged for a short-lived W&B access token instead of using an API key. The file is read again and exchanged for a new access token shortly before the previous one expires. Defaults to WANDB_IDENTITY_TOKEN_FILE environment variable. If no API key or identity token is configured, the API key stored for the base URL in ~/.netrc by `wandb login` is used.",
+			},
+			"extra_headers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Additional HTTP headers sent with every request, for example headers required by a proxy in front of W&B. The Authorization and Content-Type headers cannot be overridden.",
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path of a PEM file containing CA certificates that are trusted in addition to the system CA certificates, for W&B Server deployments using a private CA.",
+			},
+			"client_cert_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path of a PEM file containing the client certificate used for mutual TLS. Must be set together with client_key_file.",
+			},
+			"client_key_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path of a PEM file containing the private key of the client certificate used for mutual TLS. Must be set together with client_cert_file.",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to skip verification of the server's TLS certificate. This makes connections vulnerable to man-in-the-middle attacks and should only be used for testing. Defaults to false.",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "The URL of the proxy that requests are sent through. Defaults to the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables.",
+			},
+			"default_entity": schema.StringAttribute{
+				Optional:    true,
+				Description: "The entity used by resources and data sources that omit entity_name. Defaults to WANDB_ENTITY environment variable.",
+			},
+			"default_project": schema.StringAttribute{
+				Optional:    true,
+				Description: "The project used by resources that omit project_name. Defaults to WANDB_PROJECT environment variable.",
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "The maximum time a single request to the W&B API can take, as a duration such as 30s or 2m, including connecting and reading the response. Defaults to 60s.",
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to skip checking the credentials and the server version when the provider is configured. When skipped, invalid credentials are only reported by the first request, and resources are not checked against the server version. Defaults to false.",
+			},
 		},
 	}
 }
@@ -75,55 +141,240 @@ func (p *WandbLaunchProvider) Configure(ctx context.Context, req provider.Config
 	}
 
 	baseUrl := os.Getenv("WANDB_BASE_URL")
-	apiKey := os.Getenv("WANDB_API_KEY")
-
 	if !config.BaseUrl.IsNull() {
 		baseUrl = config.BaseUrl.ValueString()
 	}
 
-	if !config.ApiKey.IsNull() {
-		apiKey = config.ApiKey.ValueString()
-	}
-
 	if baseUrl == "" {
 		resp.Diagnostics.AddError(
 			"Missing W&B API base URL",
 			"Set WANDB_BASE_URL environment variable or configure the base_url.",
 		)
+		return
 	}
+	baseUrl = strings.TrimSuffix(strings.TrimSuffix(baseUrl, "/"), "/graphql")
 
-	if apiKey == "" {
+	transport, err := NewHTTPTransport(HTTPTransportConfig{
+		CACertFile:         config.CACertFile.ValueString(),
+		ClientCertFile:     config.ClientCertFile.ValueString(),
+		ClientKeyFile:      config.ClientKeyFile.ValueString(),
+		InsecureSkipVerify: config.InsecureSkipVerify.ValueBool(),
+		ProxyURL:           config.ProxyUrl.ValueString(),
+	})
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"Missing W&B API key",
-			"Set WANDB_API_KEY environment variable or configure the api_key.",
+			"Error configuring W&B API transport",
+			"Could not configure the HTTP transport, unexpected error: "+err.Error(),
 		)
+		return
+	}
+	requestTimeout := defaultRequestTimeout
+	if !config.RequestTimeout.IsNull() {
+		requestTimeout, err = time.ParseDuration(config.RequestTimeout.ValueString())
+		if err != nil || requestTimeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid request timeout",
+				fmt.Sprintf("request_timeout must be a positive duration such as 30s or 2m, got: %q.", config.RequestTimeout.ValueString()),
+			)
+			return
+		}
 	}
+	httpClient := &http.Client{Transport: transport, Timeout: requestTimeout}
 
+	authorization, tokenSource := p.authorization(ctx, config, baseUrl, httpClient, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if !strings.HasSuffix(baseUrl, "/graphql") {
-		baseUrl += "/graphql"
+	var extraHeaders map[string]string
+	resp.Diagnostics.Append(config.ExtraHeaders.ElementsAs(ctx, &extraHeaders, false)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
 	headers := http.Header{
-		"User-Agent":    []string{"terraform-provider-wandb-launch"},
-		"Authorization": []string{"Basic " + base64.StdEncoding.EncodeToString([]byte("api:"+apiKey))},
-		"Content-Type":  []string{"application/json"},
+		"User-Agent": []string{"terraform-provider-wandb-launch"},
+	}
+	for key, value := range extraHeaders {
+		headers.Set(key, value)
+	}
+	headers.Set("Authorization", authorization)
+	headers.Set("Content-Type", "application/json")
+	client := NewGraphQLClientWithHeaders(baseUrl+"/graphql", headers, httpClient)
+	client.tokenSource = tokenSource
+	client.defaultEntity = os.Getenv("WANDB_ENTITY")
+	if !config.DefaultEntity.IsNull() {
+		client.defaultEntity = config.DefaultEntity.ValueString()
 	}
-	client := NewGraphQLClientWithHeaders(baseUrl, headers)
+	client.defaultProject = os.Getenv("WANDB_PROJECT")
+	if !config.DefaultProject.IsNull() {
+		client.defaultProject = config.DefaultProject.ValueString()
+	}
+
+	tracerProvider, err := newTracerProvider(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error configuring tracing",
+			"Could not configure OpenTelemetry tracing, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if tracerProvider != nil {
+		client.tracer = tracerProvider.Tracer(tracerName)
+	}
+
+	if !config.SkipCredentialsValidation.ValueBool() {
+		p.validateCredentials(ctx, client, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
 
+// validateCredentials checks that the client can authenticate with the server, and logs the server
+// version.
+func (p *WandbLaunchProvider) validateCredentials(ctx context.Context, client *GraphQLClientWithHeaders, diags *diag.Diagnostics) {
+	viewer, serverVersion, err := readViewerHelper(ctx, client)
+	if err != nil {
+		diags.AddError(
+			"Error validating W&B credentials",
+			"Could not authenticate with the W&B server, unexpected error: "+err.Error()+
+				". Check the base_url and credentials, or set skip_credentials_validation to skip this check.",
+		)
+		return
+	}
+
+	tflog.Info(ctx, "authenticated with W&B", map[string]interface{}{
+		"username":       viewer.Username,
+		"admin":          viewer.Admin,
+		"server_version": serverVersion,
+	})
+}
+
+// authorization resolves the credentials to authenticate with and returns the Authorization header
+// value. Credentials are looked up, in order, from api_key, api_key_file, identity_token_file, the
+// WANDB_API_KEY and WANDB_IDENTITY_TOKEN_FILE environment variables and the netrc file. For identity
+// tokens, it also returns the token source that refreshes the header before the access token expires.
+func (p *WandbLaunchProvider) authorization(ctx context.Context, config WandbLaunchProviderModel, baseUrl string, httpClient *http.Client, diags *diag.Diagnostics) (string, *identityTokenSource) {
+	for name, value := range map[string]types.String{
+		"api_key":             config.ApiKey,
+		"api_key_file":        config.ApiKeyFile,
+		"identity_token_file": config.IdentityTokenFile,
+	} {
+		if value.IsUnknown() {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Unknown "+name,
+				"The provider cannot be configured because "+name+" is unknown. Set it to a value known before apply, or use an environment variable.",
+			)
+		} else if !value.IsNull() && strings.TrimSpace(value.ValueString()) == "" {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Empty "+name,
+				name+" must not be empty. Remove it to use the environment variables or the netrc file instead.",
+			)
+		}
+	}
+	if diags.HasError() {
+		return "", nil
+	}
+
+	if !config.ApiKey.IsNull() {
+		return apiKeyAuthorization(config.ApiKey.ValueString()), nil
+	}
+
+	if !config.ApiKeyFile.IsNull() {
+		apiKey, err := readAPIKeyFile(config.ApiKeyFile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("api_key_file"),
+				"Error reading W&B API key file",
+				"Could not read the API key file, unexpected error: "+err.Error(),
+			)
+			return "", nil
+		}
+		return apiKeyAuthorization(apiKey), nil
+	}
+
+	identityTokenFile := config.IdentityTokenFile.ValueString()
+	if config.IdentityTokenFile.IsNull() {
+		if apiKey := os.Getenv("WANDB_API_KEY"); apiKey != "" {
+			return apiKeyAuthorization(apiKey), nil
+		}
+		identityTokenFile = os.Getenv("WANDB_IDENTITY_TOKEN_FILE")
+	}
+
+	if identityTokenFile != "" {
+		tokenSource := &identityTokenSource{
+			httpClient: httpClient,
+			baseURL:    baseUrl,
+			tokenFile:  identityTokenFile,
+		}
+		authorization, err := tokenSource.authorization(ctx)
+		if err != nil {
+			diags.AddError(
+				"Error exchanging W&B identity token",
+				"Could not exchange the identity token for an access token, unexpected error: "+err.Error(),
+			)
+			return "", nil
+		}
+		return authorization, tokenSource
+	}
+
+	netrc, err := netrcPath()
+	if err == nil {
+		var apiKey string
+		apiKey, err = readNetrcAPIKey(netrc, baseUrl)
+		if apiKey != "" {
+			return apiKeyAuthorization(apiKey), nil
+		}
+	}
+	if err != nil {
+		diags.AddWarning("Error reading netrc file", "Could not read credentials from the netrc file: "+err.Error())
+	}
+
+	diags.AddError(
+		"Missing W&B API key",
+		"Set WANDB_API_KEY or WANDB_IDENTITY_TOKEN_FILE environment variable, configure the api_key, api_key_file or identity_token_file, or run `wandb login`.",
+	)
+	return "", nil
+}
+
 func (p *WandbLaunchProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewRunQueueResource,
+		NewUserResource,
+		NewTeamSecretResource,
+		NewArtifactAliasResource,
+		NewArtifactMetadataResource,
+		NewRegistryResource,
+		NewRegistryCollectionResource,
+		NewArtifactLinkResource,
+		NewAutomationResource,
+		NewWebhookResource,
+		NewSlackIntegrationResource,
+		NewSweepResource,
+		NewRunQueueItemResource,
+		NewStorageBucketResource,
+		NewOrganizationSettingsResource,
+		NewCustomRoleResource,
+		NewSCIMUserResource,
+		NewSCIMGroupResource,
 	}
 }
 
 func (p *WandbLaunchProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewUserDataSource,
+		NewArtifactVersionDataSource,
+		NewSlackIntegrationsDataSource,
+		NewLaunchJobDataSource,
+		NewRolePermissionsDataSource,
+	}
 }
 
 func (p *WandbLaunchProvider) Functions(ctx context.Context) []func() function.Function {
@@ -0,0 +1,481 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/machinebox/graphql"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RegistryCollectionResource{}
+var _ resource.ResourceWithConfigure = &RegistryCollectionResource{}
+var _ resource.ResourceWithImportState = &RegistryCollectionResource{}
+var _ resource.ResourceWithModifyPlan = &RegistryCollectionResource{}
+
+func NewRegistryCollectionResource() resource.Resource {
+	return &RegistryCollectionResource{}
+}
+
+type RegistryCollectionResource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type RegistryCollectionResourceModel struct {
+	Id           types.String   `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	EntityName   types.String   `tfsdk:"entity_name"`
+	Registry     types.String   `tfsdk:"registry"`
+	Type         types.String   `tfsdk:"type"`
+	Description  types.String   `tfsdk:"description"`
+	Tags         types.Set      `tfsdk:"tags"`
+	CollectionId types.String   `tfsdk:"collection_id"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *RegistryCollectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "wandb_registry_collection"
+}
+
+func (r *RegistryCollectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Registry collection resource. A collection groups the artifact versions linked into a registry, for example all versions of a production model. See [here](https://github.com/wandb/terraform-provider-wandb/blob/main/examples/resources/registry_collection/resource.tf) for an example",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the collection. This is a composite ID of the entity name, the registry name and the collection name, separated by a ':'",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the collection. This is unique within the registry.",
+			},
+			"entity_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the organization's entity that the registry belongs to. Defaults to the provider's default_entity.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"registry": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the registry, without the 'wandb-registry-' prefix.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The artifact type of the collection, for example 'model'. This must be one of the registry's allowed artifact types.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "The description of the collection.",
+			},
+			"tags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The tags of the collection.",
+			},
+			"collection_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the artifact collection.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *RegistryCollectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(client.requireServerSupport(ctx, "wandb_registry_collection", "Project", "allowAllArtifactTypesInRegistry")...)
+	r.client = client
+}
+
+func (r *RegistryCollectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		return
+	}
+
+	r.client.applyProviderDefaults(ctx, req, resp, "entity_name")
+}
+
+func (r *RegistryCollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RegistryCollectionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation CreateRegistryCollection(
+			$entityName: String!,
+			$projectName: String!,
+			$name: String!,
+			$artifactTypeName: String!,
+			$description: String,
+		) {
+			createArtifactPortfolio(input: {
+				entityName: $entityName,
+				projectName: $projectName,
+				name: $name,
+				artifactTypeName: $artifactTypeName,
+				description: $description,
+			}) {
+				artifactCollection {
+					id
+				}
+			}
+		}
+	`)
+	gqlReq.Var("entityName", data.EntityName.ValueString())
+	gqlReq.Var("projectName", registryProjectPrefix+data.Registry.ValueString())
+	gqlReq.Var("name", data.Name.ValueString())
+	gqlReq.Var("artifactTypeName", data.Type.ValueString())
+	gqlReq.Var("description", data.Description.ValueStringPointer())
+
+	var result struct {
+		CreateArtifactPortfolio struct {
+			ArtifactCollection *struct {
+				ID string `json:"id"`
+			} `json:"artifactCollection"`
+		} `json:"createArtifactPortfolio"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating registry collection",
+			"Could not create registry collection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if result.CreateArtifactPortfolio.ArtifactCollection == nil {
+		resp.Diagnostics.AddError(
+			"Failed to create registry collection",
+			"The API did not return the created registry collection.",
+		)
+		return
+	}
+
+	var tags []string
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateTags(ctx, &data, nil, tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(generateRegistryCollectionID(data.EntityName.ValueString(), data.Registry.ValueString(), data.Name.ValueString()))
+	data.CollectionId = types.StringValue(result.CreateArtifactPortfolio.ArtifactCollection.ID)
+
+	tflog.Trace(ctx, "created a registry collection resource")
+
+	resp.Diagnostics.Append(r.client.recordInstance(ctx, resp.Private)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RegistryCollectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RegistryCollectionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkInstance(ctx, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	entityName, registryName, collectionName, err := parseRegistryCollectionID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing composite ID", err.Error())
+		return
+	}
+
+	collection, err := readRegistryCollectionHelper(ctx, entityName, registryName, collectionName, r.client)
+	if errors.Is(err, errRegistryCollectionNotFound) || errors.Is(err, errRegistryNotFound) {
+		tflog.Warn(ctx, "registry collection no longer exists, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading registry collection",
+			"Could not read registry collection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Name = types.StringValue(collection.Name)
+	data.EntityName = types.StringValue(entityName)
+	data.Registry = types.StringValue(registryName)
+	data.Type = types.StringValue(collection.DefaultArtifactType.Name)
+	data.CollectionId = types.StringValue(collection.ID)
+	if !data.Description.IsNull() || (collection.Description != nil && *collection.Description != "") {
+		data.Description = types.StringPointerValue(collection.Description)
+	}
+
+	if !data.Tags.IsNull() || len(collection.Tags.Edges) > 0 {
+		tags := make([]attr.Value, 0, len(collection.Tags.Edges))
+		for _, edge := range collection.Tags.Edges {
+			tags = append(tags, types.StringValue(edge.Node.Name))
+		}
+		tagSet, diags := types.SetValue(types.StringType, tags)
+		resp.Diagnostics.Append(diags...)
+		data.Tags = tagSet
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RegistryCollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state RegistryCollectionResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation UpdateRegistryCollection($artifactPortfolioID: ID!, $name: String, $description: String) {
+			updateArtifactPortfolio(input: {artifactPortfolioID: $artifactPortfolioID, name: $name, description: $description}) {
+				artifactCollection {
+					id
+				}
+			}
+		}
+	`)
+	gqlReq.Var("artifactPortfolioID", state.CollectionId.ValueString())
+	gqlReq.Var("name", data.Name.ValueString())
+	gqlReq.Var("description", data.Description.ValueStringPointer())
+
+	var result struct {
+		UpdateArtifactPortfolio struct {
+			ArtifactCollection *struct {
+				ID string `json:"id"`
+			} `json:"artifactCollection"`
+		} `json:"updateArtifactPortfolio"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating registry collection",
+			"Could not update registry collection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if result.UpdateArtifactPortfolio.ArtifactCollection == nil {
+		resp.Diagnostics.AddError(
+			"Failed to update registry collection",
+			"The API did not confirm the update of the registry collection.",
+		)
+		return
+	}
+
+	var current, desired []string
+	resp.Diagnostics.Append(state.Tags.ElementsAs(ctx, &current, false)...)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateTags(ctx, &data, current, desired)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(generateRegistryCollectionID(data.EntityName.ValueString(), data.Registry.ValueString(), data.Name.ValueString()))
+	data.CollectionId = state.CollectionId
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RegistryCollectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RegistryCollectionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation DeleteRegistryCollection($artifactPortfolioID: ID!) {
+			deleteArtifactPortfolio(input: {artifactPortfolioID: $artifactPortfolioID}) {
+				artifactCollection {
+					id
+				}
+			}
+		}
+	`)
+	gqlReq.Var("artifactPortfolioID", data.CollectionId.ValueString())
+
+	var result struct {
+		DeleteArtifactPortfolio struct {
+			ArtifactCollection *struct {
+				ID string `json:"id"`
+			} `json:"artifactCollection"`
+		} `json:"deleteArtifactPortfolio"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting registry collection",
+			"Could not delete registry collection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if result.DeleteArtifactPortfolio.ArtifactCollection == nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete registry collection",
+			"The API did not confirm the deletion of the registry collection.",
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a registry collection resource")
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *RegistryCollectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateTags assigns and unassigns collection tags to move from current to desired.
+func (r *RegistryCollectionResource) updateTags(ctx context.Context, data *RegistryCollectionResourceModel, current, desired []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	added, removed := diffStringSets(current, desired)
+	for _, change := range []struct {
+		mutation string
+		tags     []string
+	}{
+		{"deleteArtifactCollectionTagAssignments", removed},
+		{"createArtifactCollectionTagAssignments", added},
+	} {
+		if len(change.tags) == 0 {
+			continue
+		}
+
+		gqlReq := graphql.NewRequest(fmt.Sprintf(`
+			mutation UpdateRegistryCollectionTags(
+				$entityName: String!,
+				$projectName: String!,
+				$artifactCollectionName: String!,
+				$tags: [TagInput!]!,
+			) {
+				%s(input: {
+					entityName: $entityName,
+					projectName: $projectName,
+					artifactCollectionName: $artifactCollectionName,
+					tags: $tags,
+				}) {
+					clientMutationId
+				}
+			}
+		`, change.mutation))
+		gqlReq.Var("entityName", data.EntityName.ValueString())
+		gqlReq.Var("projectName", registryProjectPrefix+data.Registry.ValueString())
+		gqlReq.Var("artifactCollectionName", data.Name.ValueString())
+		gqlReq.Var("tags", tagInputs(change.tags))
+
+		if err := r.client.Run(ctx, gqlReq, nil); err != nil {
+			diags.AddError(
+				"Error updating registry collection tags",
+				"Could not update registry collection tags, unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
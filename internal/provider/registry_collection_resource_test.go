@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccRegistryCollectionResource(t *testing.T) {
+	resourceName := "wandb_registry_collection.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckRegistryCollectionResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegistryCollectionResourceConfig("example-collection", "candidate"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "terraform-acceptance-test:terraform-collections:example-collection"),
+					resource.TestCheckResourceAttr(resourceName, "type", "model"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "candidate"),
+					resource.TestCheckResourceAttrSet(resourceName, "collection_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRegistryCollectionResourceConfig("renamed-collection", "approved"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "terraform-acceptance-test:terraform-collections:renamed-collection"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "approved"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRegistryCollectionResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "wandb_registry_collection" {
+			continue
+		}
+
+		client := newGraphQLClient()
+
+		_, err := readRegistryCollectionHelper(context.Background(), rs.Primary.Attributes["entity_name"], rs.Primary.Attributes["registry"], rs.Primary.Attributes["name"], client)
+		if err == nil {
+			return fmt.Errorf("registry collection still exists: %s", rs.Primary.ID)
+		}
+		if !errors.Is(err, errRegistryCollectionNotFound) && !errors.Is(err, errRegistryNotFound) {
+			return fmt.Errorf("checking that registry collection %s was destroyed: %w", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccRegistryCollectionResourceConfig(name, tag string) string {
+	return fmt.Sprintf(`
+resource "wandb_registry" "test" {
+  name           = "terraform-collections"
+  entity_name    = "terraform-acceptance-test"
+  artifact_types = ["model"]
+}
+
+resource "wandb_registry_collection" "test" {
+  name        = %q
+  entity_name = wandb_registry.test.entity_name
+  registry    = wandb_registry.test.name
+  type        = "model"
+  description = "Collection managed by the acceptance tests"
+  tags        = [%q]
+}
+`, name, tag)
+}
+
+func TestRegistryCollectionResourceRead_NotFound(t *testing.T) {
+	for name, response := range map[string]string{
+		"collection": `{"data": {"project": {"artifactCollection": null}}}`,
+		"registry":   `{"data": {"project": null}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			client, _ := newIntrospectionTestServer(t, nil, response)
+			r := &RegistryCollectionResource{client: client}
+
+			resp := testRead(r, testResourceState(t, r, map[string]tftypes.Value{
+				"id":          tftypes.NewValue(tftypes.String, "terraform-acceptance-test:example:example-collection"),
+				"entity_name": tftypes.NewValue(tftypes.String, "terraform-acceptance-test"),
+				"registry":    tftypes.NewValue(tftypes.String, "example"),
+				"name":        tftypes.NewValue(tftypes.String, "example-collection"),
+			}))
+			assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			assert.True(t, resp.State.Raw.IsNull())
+		})
+	}
+}
@@ -0,0 +1,697 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/machinebox/graphql"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RegistryResource{}
+var _ resource.ResourceWithConfigure = &RegistryResource{}
+var _ resource.ResourceWithImportState = &RegistryResource{}
+var _ resource.ResourceWithValidateConfig = &RegistryResource{}
+var _ resource.ResourceWithModifyPlan = &RegistryResource{}
+
+func NewRegistryResource() resource.Resource {
+	return &RegistryResource{}
+}
+
+type RegistryResource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type RegistryResourceModel struct {
+	Id            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	EntityName    types.String   `tfsdk:"entity_name"`
+	Description   types.String   `tfsdk:"description"`
+	Visibility    types.String   `tfsdk:"visibility"`
+	ArtifactTypes types.Set      `tfsdk:"artifact_types"`
+	Members       types.Set      `tfsdk:"members"`
+	ProjectId     types.String   `tfsdk:"project_id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+type RegistryMemberModel struct {
+	UserId types.String `tfsdk:"user_id"`
+	Role   types.String `tfsdk:"role"`
+}
+
+var registryMemberAttrTypes = map[string]attr.Type{
+	"user_id": types.StringType,
+	"role":    types.StringType,
+}
+
+// artifactTypesNotRemoved rejects plans that remove artifact types from a registry, since the API can
+// only add them. Allowing all artifact types again by removing artifact_types is not a removal.
+type artifactTypesNotRemoved struct{}
+
+func (m artifactTypesNotRemoved) Description(ctx context.Context) string {
+	return "Artifact types can not be removed once they have been added."
+}
+
+func (m artifactTypesNotRemoved) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m artifactTypesNotRemoved) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var current, planned []string
+	resp.Diagnostics.Append(req.StateValue.ElementsAs(ctx, &current, false)...)
+	resp.Diagnostics.Append(req.PlanValue.ElementsAs(ctx, &planned, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kept := make(map[string]bool, len(planned))
+	for _, artifactType := range planned {
+		kept[artifactType] = true
+	}
+	var removed []string
+	for _, artifactType := range current {
+		if !kept[artifactType] {
+			removed = append(removed, artifactType)
+		}
+	}
+	if len(removed) == 0 {
+		return
+	}
+
+	sort.Strings(removed)
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Artifact types cannot be removed",
+		fmt.Sprintf("The W&B API cannot remove artifact types from a registry, but the configuration removes %s. Add them back to artifact_types, or recreate the registry with fewer types.",
+			strings.Join(removed, ", ")),
+	)
+}
+
+func (r *RegistryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "wandb_registry"
+}
+
+func (r *RegistryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Registry resource used to organize artifact collections across an organization. See: https://docs.wandb.ai/guides/registry. See [here](https://github.com/wandb/terraform-provider-wandb/blob/main/examples/resources/registry/resource.tf) for an example",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the registry. This is a composite ID of the entity name and the registry name, separated by a ':'",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the registry, without the 'wandb-registry-' prefix.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the organization's entity that this registry belongs to. Defaults to the provider's default_entity.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "The description of the registry.",
+			},
+			"visibility": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Who can see the registry. Options include: organization and restricted. Restricted registries are only visible to their members. Defaults to organization.",
+			},
+			"artifact_types": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The artifact types that can be linked into the registry. If not set, all artifact types are allowed. Artifact types can not be removed once they have been added.",
+				PlanModifiers: []planmodifier.Set{
+					artifactTypesNotRemoved{},
+				},
+			},
+			"members": schema.SetNestedAttribute{
+				Optional:    true,
+				Description: "Users that are members of the registry. Only the listed members are managed, so members added outside of Terraform, such as the registry creator, are left unchanged.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Required:    true,
+							Description: "The ID of the user, for example from the wandb_user data source.",
+						},
+						"role": schema.StringAttribute{
+							Required:    true,
+							Description: "The role of the user in the registry. Options include: admin, member, viewer and restricted_viewer.",
+						},
+					},
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the project backing the registry.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *RegistryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data RegistryResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Visibility.IsNull() && !data.Visibility.IsUnknown() {
+		if _, ok := registryVisibilityToAccess[data.Visibility.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("visibility"),
+				"Invalid registry visibility",
+				"visibility must be one of: organization, restricted.",
+			)
+		}
+	}
+}
+
+func (r *RegistryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(client.requireServerSupport(ctx, "wandb_registry", "Project", "allowAllArtifactTypesInRegistry")...)
+	r.client = client
+}
+
+func (r *RegistryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		return
+	}
+
+	r.client.applyProviderDefaults(ctx, req, resp, "entity_name")
+}
+
+func (r *RegistryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RegistryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, diags := r.upsertRegistry(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var members []RegistryMemberModel
+	resp.Diagnostics.Append(data.Members.ElementsAs(ctx, &members, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateMembers(ctx, projectID, nil, members)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(generateCompositeID(data.EntityName.ValueString(), data.Name.ValueString()))
+	data.ProjectId = types.StringValue(projectID)
+
+	tflog.Trace(ctx, "created a registry resource")
+
+	resp.Diagnostics.Append(r.client.recordInstance(ctx, resp.Private)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RegistryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RegistryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkInstance(ctx, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	entityName, registryName, err := parseCompositeID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing composite ID", err.Error())
+		return
+	}
+
+	registry, err := readRegistryHelper(ctx, entityName, registryName, r.client)
+	if errors.Is(err, errRegistryNotFound) {
+		tflog.Warn(ctx, "registry no longer exists, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading registry",
+			"Could not read registry, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	visibility, err := registryAccessToVisibility(registry.Access)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading registry visibility", err.Error())
+		return
+	}
+
+	data.Name = types.StringValue(registryName)
+	data.EntityName = types.StringValue(entityName)
+	data.ProjectId = types.StringValue(registry.ID)
+	data.Visibility = types.StringValue(visibility)
+	if !data.Description.IsNull() || (registry.Description != nil && *registry.Description != "") {
+		data.Description = types.StringPointerValue(registry.Description)
+	}
+
+	if registry.AllowAllArtifactTypesInRegistry {
+		data.ArtifactTypes = types.SetNull(types.StringType)
+	} else {
+		artifactTypes := make([]attr.Value, 0, len(registry.ArtifactTypes.Edges))
+		for _, edge := range registry.ArtifactTypes.Edges {
+			artifactTypes = append(artifactTypes, types.StringValue(edge.Node.Name))
+		}
+		artifactTypeSet, diags := types.SetValue(types.StringType, artifactTypes)
+		resp.Diagnostics.Append(diags...)
+		data.ArtifactTypes = artifactTypeSet
+	}
+
+	if !data.Members.IsNull() {
+		var managed []RegistryMemberModel
+		resp.Diagnostics.Append(data.Members.ElementsAs(ctx, &managed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		members, diags := registryMembersToSet(registry.Members, managed)
+		resp.Diagnostics.Append(diags...)
+		data.Members = members
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RegistryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state RegistryResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, diags := r.upsertRegistry(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current, desired []RegistryMemberModel
+	resp.Diagnostics.Append(state.Members.ElementsAs(ctx, &current, false)...)
+	resp.Diagnostics.Append(data.Members.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateMembers(ctx, projectID, current, desired)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(generateCompositeID(data.EntityName.ValueString(), data.Name.ValueString()))
+	data.ProjectId = types.StringValue(projectID)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RegistryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RegistryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation DeleteRegistry($id: String!) {
+			deleteModel(input: {id: $id}) {
+				success
+			}
+		}
+	`)
+	gqlReq.Var("id", data.ProjectId.ValueString())
+
+	var result struct {
+		DeleteModel struct {
+			Success bool `json:"success"`
+		} `json:"deleteModel"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting registry",
+			"Could not delete registry, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !result.DeleteModel.Success {
+		resp.Diagnostics.AddError(
+			"Failed to delete registry",
+			"The API did not confirm the deletion of the registry.",
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a registry resource")
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *RegistryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// upsertRegistry creates or updates the project backing the registry and returns its ID.
+// An unset visibility is resolved to its default in data.
+func (r *RegistryResource) upsertRegistry(ctx context.Context, data *RegistryResourceModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if data.Visibility.IsNull() || data.Visibility.IsUnknown() {
+		data.Visibility = types.StringValue("organization")
+	}
+
+	var artifactTypes []string
+	diags.Append(data.ArtifactTypes.ElementsAs(ctx, &artifactTypes, false)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation UpsertRegistry(
+			$entityName: String!,
+			$name: String!,
+			$description: String,
+			$access: String,
+			$allowAllArtifactTypesInRegistry: Boolean,
+			$artifactTypes: [String!],
+		) {
+			upsertModel(input: {
+				entityName: $entityName,
+				name: $name,
+				description: $description,
+				access: $access,
+				allowAllArtifactTypesInRegistry: $allowAllArtifactTypesInRegistry,
+				artifactTypes: $artifactTypes,
+			}) {
+				model {
+					id
+				}
+			}
+		}
+	`)
+	gqlReq.Var("entityName", data.EntityName.ValueString())
+	gqlReq.Var("name", registryProjectPrefix+data.Name.ValueString())
+	gqlReq.Var("description", data.Description.ValueStringPointer())
+	gqlReq.Var("access", registryVisibilityToAccess[data.Visibility.ValueString()])
+	gqlReq.Var("allowAllArtifactTypesInRegistry", data.ArtifactTypes.IsNull())
+	gqlReq.Var("artifactTypes", artifactTypes)
+
+	var result struct {
+		UpsertModel struct {
+			Model *struct {
+				ID string `json:"id"`
+			} `json:"model"`
+		} `json:"upsertModel"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		diags.AddError(
+			"Error upserting registry",
+			"Could not upsert registry, unexpected error: "+err.Error(),
+		)
+		return "", diags
+	}
+
+	if result.UpsertModel.Model == nil {
+		diags.AddError(
+			"Failed to upsert registry",
+			"The API did not return the registry.",
+		)
+		return "", diags
+	}
+
+	return result.UpsertModel.Model.ID, diags
+}
+
+// updateMembers adds, removes and changes the roles of registry members to move from current to desired.
+func (r *RegistryResource) updateMembers(ctx context.Context, projectID string, current, desired []RegistryMemberModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	currentRoles := make(map[string]string, len(current))
+	for _, m := range current {
+		currentRoles[m.UserId.ValueString()] = m.Role.ValueString()
+	}
+	desiredRoles := make(map[string]string, len(desired))
+	for _, m := range desired {
+		desiredRoles[m.UserId.ValueString()] = m.Role.ValueString()
+	}
+
+	var removed []string
+	for userID := range currentRoles {
+		if _, ok := desiredRoles[userID]; !ok {
+			removed = append(removed, userID)
+		}
+	}
+	sort.Strings(removed)
+
+	if len(removed) > 0 {
+		gqlReq := graphql.NewRequest(`
+			mutation DeleteRegistryMembers($userIds: [ID!]!, $projectId: ID!) {
+				deleteProjectMembers(input: {userIds: $userIds, projectId: $projectId}) {
+					success
+				}
+			}
+		`)
+		gqlReq.Var("userIds", removed)
+		gqlReq.Var("projectId", projectID)
+
+		var result struct {
+			DeleteProjectMembers struct {
+				Success bool `json:"success"`
+			} `json:"deleteProjectMembers"`
+		}
+		if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+			diags.AddError(
+				"Error removing registry members",
+				"Could not remove registry members, unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+		if !result.DeleteProjectMembers.Success {
+			diags.AddError(
+				"Failed to remove registry members",
+				"The API did not confirm that the registry members were removed.",
+			)
+			return diags
+		}
+	}
+
+	var added []string
+	for userID := range desiredRoles {
+		if _, ok := currentRoles[userID]; !ok {
+			added = append(added, userID)
+		}
+	}
+	sort.Strings(added)
+
+	if len(added) > 0 {
+		gqlReq := graphql.NewRequest(`
+			mutation CreateRegistryMembers($userIds: [ID!]!, $projectId: ID!) {
+				createProjectMembers(input: {userIds: $userIds, projectId: $projectId}) {
+					success
+				}
+			}
+		`)
+		gqlReq.Var("userIds", added)
+		gqlReq.Var("projectId", projectID)
+
+		var result struct {
+			CreateProjectMembers struct {
+				Success bool `json:"success"`
+			} `json:"createProjectMembers"`
+		}
+		if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+			diags.AddError(
+				"Error adding registry members",
+				"Could not add registry members, unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+		if !result.CreateProjectMembers.Success {
+			diags.AddError(
+				"Failed to add registry members",
+				"The API did not confirm that the registry members were added.",
+			)
+			return diags
+		}
+	}
+
+	// New members are added with the default role, so their role is set along with changed roles.
+	for userID, role := range desiredRoles {
+		if currentRoles[userID] == role {
+			continue
+		}
+
+		gqlReq := graphql.NewRequest(`
+			mutation UpdateRegistryMember($userId: ID!, $projectId: ID!, $userProjectRole: String!) {
+				updateProjectMember(input: {userId: $userId, projectId: $projectId, userProjectRole: $userProjectRole}) {
+					success
+				}
+			}
+		`)
+		gqlReq.Var("userId", userID)
+		gqlReq.Var("projectId", projectID)
+		gqlReq.Var("userProjectRole", role)
+
+		var result struct {
+			UpdateProjectMember struct {
+				Success bool `json:"success"`
+			} `json:"updateProjectMember"`
+		}
+		if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+			diags.AddError(
+				"Error updating registry member",
+				"Could not update registry member "+userID+", unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+		if !result.UpdateProjectMember.Success {
+			diags.AddError(
+				"Failed to update registry member",
+				"The API did not confirm the role change of registry member "+userID+".",
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// registryMembersToSet converts the registry's members into state, keeping only the managed members.
+func registryMembersToSet(members []ProjectMember, managed []RegistryMemberModel) (types.Set, diag.Diagnostics) {
+	managedUsers := make(map[string]bool, len(managed))
+	for _, m := range managed {
+		managedUsers[m.UserId.ValueString()] = true
+	}
+
+	elementType := types.ObjectType{AttrTypes: registryMemberAttrTypes}
+	result := make([]attr.Value, 0, len(managed))
+	for _, member := range members {
+		if !managedUsers[member.ID] {
+			continue
+		}
+		obj, diags := types.ObjectValue(registryMemberAttrTypes, map[string]attr.Value{
+			"user_id": types.StringValue(member.ID),
+			"role":    types.StringValue(member.Role.Name),
+		})
+		if diags.HasError() {
+			return types.SetNull(elementType), diags
+		}
+		result = append(result, obj)
+	}
+	return types.SetValue(elementType, result)
+}
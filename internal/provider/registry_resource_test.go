@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccRegistryResource(t *testing.T) {
+	resourceName := "wandb_registry.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckRegistryResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegistryResourceConfig("organization"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "terraform-acceptance-test:terraform-example"),
+					resource.TestCheckResourceAttr(resourceName, "visibility", "organization"),
+					resource.TestCheckResourceAttr(resourceName, "artifact_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "artifact_types.*", "model"),
+					resource.TestCheckResourceAttrSet(resourceName, "project_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRegistryResourceConfig("restricted"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "visibility", "restricted"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRegistryResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "wandb_registry" {
+			continue
+		}
+
+		client := newGraphQLClient()
+
+		_, err := readRegistryHelper(context.Background(), rs.Primary.Attributes["entity_name"], rs.Primary.Attributes["name"], client)
+		if err == nil {
+			return fmt.Errorf("registry still exists: %s", rs.Primary.ID)
+		}
+		if !errors.Is(err, errRegistryNotFound) {
+			return fmt.Errorf("checking that registry %s was destroyed: %w", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccRegistryResourceConfig(visibility string) string {
+	return fmt.Sprintf(`
+resource "wandb_registry" "test" {
+  name           = "terraform-example"
+  entity_name    = "terraform-acceptance-test"
+  description    = "Registry managed by the acceptance tests"
+  visibility     = %q
+  artifact_types = ["model"]
+}
+`, visibility)
+}
+
+func TestRegistryResourceUpdateMembers_Unsuccessful(t *testing.T) {
+	client, _ := newIntrospectionTestServer(t, nil, `{"data": {"createProjectMembers": {"success": false}}}`)
+	r := &RegistryResource{client: client}
+
+	diags := r.updateMembers(context.Background(), "project-id", nil, []RegistryMemberModel{
+		{UserId: types.StringValue("user-id"), Role: types.StringValue("member")},
+	})
+	if assert.True(t, diags.HasError()) {
+		assert.Equal(t, "Failed to add registry members", diags.Errors()[0].Summary())
+	}
+}
+
+func TestRegistryResourceRead_NotFound(t *testing.T) {
+	client, _ := newIntrospectionTestServer(t, nil, `{"data": {"project": null}}`)
+	r := &RegistryResource{client: client}
+
+	resp := testRead(r, testResourceState(t, r, map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, "terraform-acceptance-test:example"),
+		"entity_name": tftypes.NewValue(tftypes.String, "terraform-acceptance-test"),
+		"name":        tftypes.NewValue(tftypes.String, "example"),
+	}))
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
+
+func TestArtifactTypesNotRemoved(t *testing.T) {
+	ctx := context.Background()
+	artifactTypes := func(names ...string) types.Set {
+		values := make([]attr.Value, 0, len(names))
+		for _, name := range names {
+			values = append(values, types.StringValue(name))
+		}
+		return types.SetValueMust(types.StringType, values)
+	}
+
+	for name, tc := range map[string]struct {
+		state, plan types.Set
+		removed     string
+	}{
+		"added":       {state: artifactTypes("model"), plan: artifactTypes("model", "dataset")},
+		"unchanged":   {state: artifactTypes("model", "dataset"), plan: artifactTypes("dataset", "model")},
+		"allow all":   {state: artifactTypes("model"), plan: types.SetNull(types.StringType)},
+		"restricted":  {state: types.SetNull(types.StringType), plan: artifactTypes("model")},
+		"unknown":     {state: artifactTypes("model"), plan: types.SetUnknown(types.StringType)},
+		"removed":     {state: artifactTypes("model", "dataset"), plan: artifactTypes("model"), removed: "dataset"},
+		"all removed": {state: artifactTypes("model", "dataset"), plan: artifactTypes(), removed: "dataset, model"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.SetRequest{
+				Path:       path.Root("artifact_types"),
+				StateValue: tc.state,
+				PlanValue:  tc.plan,
+			}
+			resp := &planmodifier.SetResponse{PlanValue: req.PlanValue}
+			artifactTypesNotRemoved{}.PlanModifySet(ctx, req, resp)
+
+			if tc.removed == "" {
+				assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+				return
+			}
+			if assert.True(t, resp.Diagnostics.HasError()) {
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "removes "+tc.removed+".")
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RolePermissionsDataSource{}
+var _ datasource.DataSourceWithConfigure = &RolePermissionsDataSource{}
+
+func NewRolePermissionsDataSource() datasource.DataSource {
+	return &RolePermissionsDataSource{}
+}
+
+type RolePermissionsDataSource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type RolePermissionsDataSourceModel struct {
+	Id               types.String          `tfsdk:"id"`
+	OrganizationName types.String          `tfsdk:"organization_name"`
+	Group            types.String          `tfsdk:"group"`
+	Permissions      []RolePermissionModel `tfsdk:"permissions"`
+}
+
+type RolePermissionModel struct {
+	Name        types.String `tfsdk:"name"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Description types.String `tfsdk:"description"`
+	Group       types.String `tfsdk:"group"`
+}
+
+func (d *RolePermissionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "wandb_role_permissions"
+}
+
+func (d *RolePermissionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the permissions that can be granted to custom roles in an organization, so their names can be used in the permissions of wandb_custom_role.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the organization.",
+			},
+			"organization_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the organization to list permissions for.",
+			},
+			"group": schema.StringAttribute{
+				Optional:    true,
+				Description: "If set, only permissions in this group are returned, for example artifacts or launch.",
+			},
+			"permissions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The permissions that can be granted to custom roles.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the permission.",
+						},
+						"display_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The human readable name of the permission.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "A description of what the permission allows.",
+						},
+						"group": schema.StringAttribute{
+							Computed:    true,
+							Description: "The group the permission belongs to.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RolePermissionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(client.requireServerSupport(ctx, "wandb_role_permissions", "Organization", "availablePermissions")...)
+	d.client = client
+}
+
+func (d *RolePermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RolePermissionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, err := readRolePermissionsHelper(ctx, data.OrganizationName.ValueString(), d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading role permissions",
+			"Could not read role permissions, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Permissions = []RolePermissionModel{}
+	for _, permission := range permissions {
+		if !data.Group.IsNull() && (permission.Group == nil || *permission.Group != data.Group.ValueString()) {
+			continue
+		}
+		data.Permissions = append(data.Permissions, RolePermissionModel{
+			Name:        types.StringValue(permission.Name),
+			DisplayName: types.StringPointerValue(permission.DisplayName),
+			Description: types.StringPointerValue(permission.Description),
+			Group:       types.StringPointerValue(permission.Group),
+		})
+	}
+	data.Id = data.OrganizationName
+
+	tflog.Trace(ctx, "read a role permissions data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRolePermissionsDataSource(t *testing.T) {
+	dataSourceName := "data.wandb_role_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePermissionsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "terraform-acceptance-test"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "permissions.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRolePermissionsDataSourceConfig() string {
+	return `
+data "wandb_role_permissions" "test" {
+  organization_name = "terraform-acceptance-test"
+}
+`
+}
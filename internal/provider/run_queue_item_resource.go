@@ -0,0 +1,506 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/machinebox/graphql"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RunQueueItemResource{}
+var _ resource.ResourceWithConfigure = &RunQueueItemResource{}
+var _ resource.ResourceWithValidateConfig = &RunQueueItemResource{}
+
+func NewRunQueueItemResource() resource.Resource {
+	return &RunQueueItemResource{}
+}
+
+type RunQueueItemResource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type RunQueueItemResourceModel struct {
+	Id                     types.String   `tfsdk:"id"`
+	ItemId                 types.String   `tfsdk:"item_id"`
+	QueueId                types.String   `tfsdk:"queue_id"`
+	Job                    types.String   `tfsdk:"job"`
+	ProjectName            types.String   `tfsdk:"project_name"`
+	Priority               types.String   `tfsdk:"priority"`
+	TemplateVariableValues types.Map      `tfsdk:"template_variable_values"`
+	Overrides              types.String   `tfsdk:"overrides"`
+	State                  types.String   `tfsdk:"state"`
+	RunId                  types.String   `tfsdk:"run_id"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *RunQueueItemResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "wandb_run_queue_item"
+}
+
+func (r *RunQueueItemResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Run queue item resource that enqueues a launch job onto a run queue, for example a scheduled retraining or an evaluation run. Queue items cannot be changed once enqueued, so any change enqueues a new item. See [here](https://github.com/wandb/terraform-provider-wandb/blob/main/examples/resources/run_queue_item/resource.tf) for an example",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the run queue item resource. This is a composite ID of the entity name, queue name and item ID, separated by a ':'",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"item_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the run queue item.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"queue_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the wandb_run_queue to enqueue the job onto.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"job": schema.StringAttribute{
+				Required:    true,
+				Description: "The launch job to run, in the form entity/project/job-name:alias.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The project the launched run logs to. Defaults to the project of the job.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"priority": schema.StringAttribute{
+				Optional:    true,
+				Description: "The priority of the item. Options include: critical, high, medium and low. Only supported on queues with prioritization enabled.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"template_variable_values": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Values for the template variables defined on the run queue, keyed by variable name. Values are checked against the variable's schema before enqueueing.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"overrides": schema.StringAttribute{
+				Optional:    true,
+				Description: "Overrides for the job as a JSON string, for example {\"run_config\": {\"epochs\": 5}}.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The state of the item, for example PENDING, CLAIMED or FINISHED.",
+			},
+			"run_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the run launched for the item, once an agent has claimed it.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *RunQueueItemResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data RunQueueItemResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Priority.IsNull() && !data.Priority.IsUnknown() {
+		if _, ok := runQueuePriorities[data.Priority.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("priority"),
+				"Invalid run queue item priority",
+				"priority must be one of: critical, high, medium, low.",
+			)
+		}
+	}
+
+	if !data.Overrides.IsNull() && !data.Overrides.IsUnknown() {
+		if _, err := normalizeJSONObject(data.Overrides.ValueStringPointer()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("overrides"),
+				"Invalid run queue item overrides",
+				"overrides must be a JSON object: "+err.Error(),
+			)
+		}
+	}
+}
+
+func (r *RunQueueItemResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RunQueueItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RunQueueItemResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entityName, queueName, err := parseCompositeID(data.QueueId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing run queue ID", err.Error())
+		return
+	}
+
+	runQueue, err := readRunQueueHelper(entityName, queueName, ctx, *r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading run queue",
+			"Could not read run queue, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	jobPath, err := parseArtifactPath(data.Job.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("job"), "Error parsing job path", err.Error())
+		return
+	}
+
+	var priority *int
+	if !data.Priority.IsNull() {
+		// Servers that predate prioritization do not report the mode, so it cannot be verified.
+		switch runQueue.PrioritizationMode {
+		case "V0":
+		case "":
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("priority"),
+				"Could not verify run queue prioritization",
+				"The server did not report whether run queue "+queueName+" has prioritization enabled. The priority is sent as configured.",
+			)
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("priority"),
+				"Run queue does not support priorities",
+				"Run queue "+queueName+" does not have prioritization enabled, so priority cannot be set.",
+			)
+			return
+		}
+		value := runQueuePriorities[data.Priority.ValueString()]
+		priority = &value
+	}
+
+	var templateVariableValues *string
+	if !data.TemplateVariableValues.IsNull() {
+		var values map[string]string
+		resp.Diagnostics.Append(data.TemplateVariableValues.ElementsAs(ctx, &values, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resolved, err := resolveTemplateVariableValues(values, runQueue.DefaultResourceConfig.TemplateVariables)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("template_variable_values"), "Invalid template variable values", err.Error())
+			return
+		}
+		valuesBytes, err := json.Marshal(resolved)
+		if err != nil {
+			resp.Diagnostics.AddError("Error encoding template variable values", err.Error())
+			return
+		}
+		valuesString := string(valuesBytes)
+		templateVariableValues = &valuesString
+	}
+
+	projectName := jobPath.ProjectName
+	if !data.ProjectName.IsNull() {
+		projectName = data.ProjectName.ValueString()
+	}
+	runSpec := map[string]interface{}{
+		"job":      jobPath.String(),
+		"entity":   entityName,
+		"project":  projectName,
+		"resource": runQueue.DefaultResourceConfig.Resource,
+	}
+	if !data.Overrides.IsNull() {
+		var overrides map[string]interface{}
+		if err := json.Unmarshal([]byte(data.Overrides.ValueString()), &overrides); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("overrides"), "Error parsing overrides", err.Error())
+			return
+		}
+		runSpec["overrides"] = overrides
+	}
+	runSpecBytes, err := json.Marshal(runSpec)
+	if err != nil {
+		resp.Diagnostics.AddError("Error encoding run spec", err.Error())
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation PushToRunQueue(
+			$queueID: ID!,
+			$runSpec: JSONString!,
+			$priority: Int,
+			$templateVariableValues: JSONString,
+		) {
+			pushToRunQueue(input: {
+				queueID: $queueID,
+				runSpec: $runSpec,
+				priority: $priority,
+				templateVariableValues: $templateVariableValues,
+			}) {
+				runQueueItemId
+			}
+		}
+	`)
+	gqlReq.Var("queueID", runQueue.ID)
+	gqlReq.Var("runSpec", string(runSpecBytes))
+	gqlReq.Var("priority", priority)
+	gqlReq.Var("templateVariableValues", templateVariableValues)
+
+	var result struct {
+		PushToRunQueue *struct {
+			RunQueueItemID string `json:"runQueueItemId"`
+		} `json:"pushToRunQueue"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating run queue item",
+			"Could not push job to run queue, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if result.PushToRunQueue == nil {
+		resp.Diagnostics.AddError(
+			"Failed to create run queue item",
+			"The API did not return the created run queue item.",
+		)
+		return
+	}
+
+	itemID := result.PushToRunQueue.RunQueueItemID
+	item, err := readRunQueueItemHelper(ctx, entityName, queueName, itemID, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading run queue item",
+			"Could not read run queue item, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(generateRunQueueItemID(entityName, queueName, itemID))
+	data.ItemId = types.StringValue(itemID)
+	data.State = types.StringValue(item.State)
+	data.RunId = types.StringPointerValue(item.AssociatedRunID)
+
+	tflog.Trace(ctx, "created a run queue item resource")
+
+	resp.Diagnostics.Append(r.client.recordInstance(ctx, resp.Private)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RunQueueItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RunQueueItemResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkInstance(ctx, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	entityName, queueName, itemID, err := parseRunQueueItemID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing composite ID", err.Error())
+		return
+	}
+
+	item, err := readRunQueueItemHelper(ctx, entityName, queueName, itemID, r.client)
+	if errors.Is(err, errRunQueueItemNotFound) {
+		tflog.Warn(ctx, "run queue item no longer exists, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading run queue item",
+			"Could not read run queue item, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.State = types.StringValue(item.State)
+	data.RunId = types.StringPointerValue(item.AssociatedRunID)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RunQueueItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RunQueueItemResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every configurable attribute forces replacement, so there is nothing to update in the API.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RunQueueItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RunQueueItemResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entityName, queueName, itemID, err := parseRunQueueItemID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing composite ID", err.Error())
+		return
+	}
+
+	item, err := readRunQueueItemHelper(ctx, entityName, queueName, itemID, r.client)
+	if errors.Is(err, errRunQueueItemNotFound) {
+		tflog.Warn(ctx, "run queue item no longer exists, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading run queue item",
+			"Could not read run queue item, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Items that an agent has already claimed have launched a run, which is left running.
+	if item.State != "PENDING" {
+		tflog.Trace(ctx, "run queue item already claimed, removing from state only")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	runQueue, err := readRunQueueHelper(entityName, queueName, ctx, *r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading run queue",
+			"Could not read run queue, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation DeleteFromRunQueue($queueID: ID!, $runQueueItemId: ID!) {
+			deleteFromRunQueue(input: {queueID: $queueID, runQueueItemId: $runQueueItemId}) {
+				success
+			}
+		}
+	`)
+	gqlReq.Var("queueID", runQueue.ID)
+	gqlReq.Var("runQueueItemId", itemID)
+
+	var result struct {
+		DeleteFromRunQueue struct {
+			Success bool `json:"success"`
+		} `json:"deleteFromRunQueue"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting run queue item",
+			"Could not delete run queue item, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !result.DeleteFromRunQueue.Success {
+		resp.Diagnostics.AddError(
+			"Failed to delete run queue item",
+			"The API did not confirm the deletion of the run queue item.",
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a run queue item resource")
+
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccRunQueueItemResource(t *testing.T) {
+	resourceName := "wandb_run_queue_item.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckRunQueueItemResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunQueueItemResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "item_id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "PENDING"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "high"),
+					resource.TestCheckResourceAttr(resourceName, "template_variable_values.gpus", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRunQueueItemResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "wandb_run_queue_item" {
+			continue
+		}
+
+		entityName, queueName, itemID, err := parseRunQueueItemID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = readRunQueueItemHelper(context.Background(), entityName, queueName, itemID, newGraphQLClient())
+		if err == nil {
+			return fmt.Errorf("run queue item still exists: %s", rs.Primary.ID)
+		}
+		if !errors.Is(err, errRunQueueItemNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccRunQueueItemResourceConfig() string {
+	return `
+resource "wandb_run_queue" "test" {
+  name        = "terraform-item-queue"
+  entity_name = "terraform-acceptance-test"
+  resource    = "local-container"
+
+  resource_config = jsonencode({
+    gpus = "{{gpus}}"
+  })
+
+  template_variables = jsonencode({
+    gpus = {
+      description = "The number of GPUs",
+      schema = {
+        type    = "integer",
+        minimum = 1,
+        maximum = 4
+      }
+    }
+  })
+
+  prioritization_mode = "V0"
+}
+
+resource "wandb_run_queue_item" "test" {
+  queue_id = wandb_run_queue.test.id
+  job      = "terraform-acceptance-test/launch/job-example:latest"
+  priority = "high"
+
+  template_variable_values = {
+    gpus = "2"
+  }
+
+  overrides = jsonencode({
+    run_config = {
+      epochs = 1
+    }
+  })
+}
+`
+}
+
+// newRunQueueItemsTestServer returns a client for a GraphQL server whose run queue holds the items
+// with the given IDs, served in pages of two.
+func newRunQueueItemsTestServer(t *testing.T, itemIDs ...string) (*GraphQLClientWithHeaders, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var body struct {
+			Variables struct {
+				After *string `json:"after"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("invalid request body: %v", err)
+		}
+
+		start := 0
+		if body.Variables.After != nil {
+			_, _ = fmt.Sscanf(*body.Variables.After, "cursor-%d", &start)
+		}
+		end := start + 2
+		if end > len(itemIDs) {
+			end = len(itemIDs)
+		}
+		edges := []map[string]interface{}{}
+		for _, id := range itemIDs[start:end] {
+			edges = append(edges, map[string]interface{}{"node": map[string]string{"id": id, "state": "PENDING"}})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"project": map[string]interface{}{
+					"runQueue": map[string]interface{}{
+						"runQueueItems": map[string]interface{}{
+							"pageInfo": map[string]interface{}{"hasNextPage": end < len(itemIDs), "endCursor": fmt.Sprintf("cursor-%d", end)},
+							"edges":    edges,
+						},
+					},
+				},
+			},
+		})
+	}))
+	t.Cleanup(server.Close)
+	return NewGraphQLClientWithHeaders(server.URL+"/graphql", http.Header{}, nil), &requests
+}
+
+func TestReadRunQueueItemHelper_Pagination(t *testing.T) {
+	client, requests := newRunQueueItemsTestServer(t, "item-1", "item-2", "item-3", "item-4", "item-5")
+
+	item, err := readRunQueueItemHelper(context.Background(), "example", "example", "item-5", client)
+	assert.NoError(t, err)
+	if assert.NotNil(t, item) {
+		assert.Equal(t, "item-5", item.ID)
+	}
+	assert.Equal(t, 3, *requests)
+
+	_, err = readRunQueueItemHelper(context.Background(), "example", "example", "item-6", client)
+	assert.ErrorIs(t, err, errRunQueueItemNotFound)
+}
+
+func TestReadRunQueueItemHelper_QueueDeleted(t *testing.T) {
+	client, _ := newIntrospectionTestServer(t, nil, `{"data": {"project": {"runQueue": null}}}`)
+
+	_, err := readRunQueueItemHelper(context.Background(), "example", "example", "item-1", client)
+	assert.ErrorIs(t, err, errRunQueueItemNotFound)
+}
+
+func TestRunQueueItemResource_NotFound(t *testing.T) {
+	client, _ := newRunQueueItemsTestServer(t, "item-1")
+	r := &RunQueueItemResource{client: client}
+	attributes := map[string]tftypes.Value{
+		"id":      tftypes.NewValue(tftypes.String, generateRunQueueItemID("example", "example", "drained-item")),
+		"item_id": tftypes.NewValue(tftypes.String, "drained-item"),
+	}
+
+	readResp := testRead(r, testResourceState(t, r, attributes))
+	assert.False(t, readResp.Diagnostics.HasError(), "%v", readResp.Diagnostics)
+	assert.True(t, readResp.State.Raw.IsNull())
+
+	deleteResp := testDelete(r, testResourceState(t, r, attributes))
+	assert.False(t, deleteResp.Diagnostics.HasError(), "%v", deleteResp.Diagnostics)
+	assert.True(t, deleteResp.State.Raw.IsNull())
+}
@@ -9,9 +9,14 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/machinebox/graphql"
@@ -21,6 +26,8 @@ import (
 var _ resource.Resource = &RunQueueResource{}
 var _ resource.ResourceWithConfigure = &RunQueueResource{}
 var _ resource.ResourceWithImportState = &RunQueueResource{}
+var _ resource.ResourceWithValidateConfig = &RunQueueResource{}
+var _ resource.ResourceWithModifyPlan = &RunQueueResource{}
 
 func NewRunQueueResource() resource.Resource {
 	return &RunQueueResource{}
@@ -31,14 +38,26 @@ type RunQueueResource struct {
 }
 
 type RunQueueResourceModel struct {
-	Id                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	EntityName         types.String `tfsdk:"entity_name"`
-	Resource           types.String `tfsdk:"resource"`
-	ResourceConfig     types.String `tfsdk:"resource_config"`
-	TemplateVariables  types.String `tfsdk:"template_variables"`
-	PrioritizationMode types.String `tfsdk:"prioritization_mode"`
-	ExternalLinks      types.Map    `tfsdk:"external_links"`
+	Id                       types.String         `tfsdk:"id"`
+	Name                     types.String         `tfsdk:"name"`
+	EntityName               types.String         `tfsdk:"entity_name"`
+	Resource                 types.String         `tfsdk:"resource"`
+	ResourceConfig           types.String         `tfsdk:"resource_config"`
+	TemplateVariables        types.String         `tfsdk:"template_variables"`
+	PrioritizationMode       types.String         `tfsdk:"prioritization_mode"`
+	DefaultPriority          types.String         `tfsdk:"default_priority"`
+	MaxConcurrentRuns        types.Int64          `tfsdk:"max_concurrent_runs"`
+	MaxConcurrentRunsPerUser types.Int64          `tfsdk:"max_concurrent_runs_per_user"`
+	Access                   *RunQueueAccessModel `tfsdk:"access"`
+	ExternalLinks            types.Map            `tfsdk:"external_links"`
+	Timeouts                 timeouts.Value       `tfsdk:"timeouts"`
+}
+
+type RunQueueAccessModel struct {
+	Users           types.Set  `tfsdk:"users"`
+	Teams           types.Set  `tfsdk:"teams"`
+	ServiceAccounts types.Set  `tfsdk:"service_accounts"`
+	AdminOnlyConfig types.Bool `tfsdk:"admin_only_config"`
 }
 
 func (r *RunQueueResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -59,8 +78,12 @@ func (r *RunQueueResource) Schema(ctx context.Context, req resource.SchemaReques
 				Description: "The name of the run queue. This is unique within the entity.",
 			},
 			"entity_name": schema.StringAttribute{
-				Required:    true,
-				Description: "The name of the entity that this run queue belongs to.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the entity that this run queue belongs to. Defaults to the provider's default_entity.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"resource": schema.StringAttribute{
 				Required:    true,
@@ -78,12 +101,100 @@ func (r *RunQueueResource) Schema(ctx context.Context, req resource.SchemaReques
 				Optional:    true,
 				Description: "The prioritization mode for the run queue. Options include: disabled and V0. V0 allows users to specify priority when launching items. Once a queue specifies V0, it can not be disabled.",
 			},
+			"default_priority": schema.StringAttribute{
+				Optional:    true,
+				Description: "The priority given to items enqueued without a priority. Options include: critical, high, medium and low. Requires the V0 prioritization mode.",
+			},
+			"max_concurrent_runs": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of runs from this queue that can run at the same time. Unlimited if unset.",
+			},
+			"max_concurrent_runs_per_user": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of runs from this queue that a single user can have running at the same time. Unlimited if unset.",
+			},
+			"access": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Restricts who can push to the run queue. If unset, every member of the entity can push to the queue.",
+				Attributes: map[string]schema.Attribute{
+					"users": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "The usernames of the users that can push to the queue.",
+					},
+					"teams": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "The names of the teams whose members can push to the queue.",
+					},
+					"service_accounts": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "The usernames of the service accounts that can push to the queue.",
+					},
+					"admin_only_config": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether only entity admins can edit the queue's configuration. Defaults to false.",
+					},
+				},
+			},
 			"external_links": schema.MapAttribute{
 				Optional:    true,
 				ElementType: types.StringType,
 				Description: "A map of external links for the run queue. Provided as a map with the key being the label, and the value being the URL.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *RunQueueResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data RunQueueResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.DefaultPriority.IsNull() && !data.DefaultPriority.IsUnknown() {
+		if _, ok := runQueuePriorities[data.DefaultPriority.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("default_priority"),
+				"Invalid default priority",
+				"default_priority must be one of: critical, high, medium, low.",
+			)
+		}
+		if data.PrioritizationMode.ValueString() == "disabled" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("default_priority"),
+				"Invalid default priority",
+				"default_priority cannot be set when prioritization_mode is disabled.",
+			)
+		}
+	}
+
+	for _, limit := range []struct {
+		name  string
+		value types.Int64
+	}{
+		{"max_concurrent_runs", data.MaxConcurrentRuns},
+		{"max_concurrent_runs_per_user", data.MaxConcurrentRunsPerUser},
+	} {
+		if !limit.value.IsNull() && !limit.value.IsUnknown() && limit.value.ValueInt64() < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(limit.name),
+				"Invalid concurrency limit",
+				limit.name+" must be at least 1.",
+			)
+		}
 	}
 }
 
@@ -107,6 +218,42 @@ func (r *RunQueueResource) Configure(ctx context.Context, req resource.Configure
 	r.client = client
 }
 
+func (r *RunQueueResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		return
+	}
+
+	r.client.applyProviderDefaults(ctx, req, resp, "entity_name")
+
+	// Nothing to check when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	for _, optional := range []struct {
+		attribute string
+		field     string
+	}{
+		{"prioritization_mode", "prioritizationMode"},
+		{"default_priority", "defaultPriority"},
+		{"max_concurrent_runs", "maxConcurrentRuns"},
+		{"max_concurrent_runs_per_user", "maxConcurrentRunsPerUser"},
+		{"access", "access"},
+		{"external_links", "externalLinks"},
+	} {
+		var value attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(optional.attribute), &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if value.IsNull() {
+			continue
+		}
+		resp.Diagnostics.Append(r.client.requireServerField(ctx, "UpsertRunQueueInput", optional.field, path.Root(optional.attribute))...)
+	}
+}
+
 func (r *RunQueueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data RunQueueResourceModel
 
@@ -117,12 +264,24 @@ func (r *RunQueueResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	externalLinks, err := convertExternalLinksMapToInputType(data.ExternalLinks.Elements())
 	if err != nil {
 		resp.Diagnostics.AddError("Error converting external links", err.Error())
 		return
 	}
 
+	access, diags := runQueueAccessInput(ctx, data.Access)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	prioritizationMode := data.PrioritizationMode.ValueStringPointer()
 	if prioritizationMode == nil {
 		defaultPrioritizationMode := "V0"
@@ -146,14 +305,18 @@ func (r *RunQueueResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	input := UpsertRunQueueInput{
-		QueueName:          data.Name.ValueString(),
-		EntityName:         data.EntityName.ValueString(),
-		ProjectName:        "model-registry",
-		ResourceType:       data.Resource.ValueString(),
-		ResourceConfig:     resourceConfig,
-		TemplateVariables:  data.TemplateVariables.ValueStringPointer(),
-		PrioritizationMode: prioritizationMode,
-		ExternalLinks:      externalLinks,
+		QueueName:                data.Name.ValueString(),
+		EntityName:               data.EntityName.ValueString(),
+		ProjectName:              "model-registry",
+		ResourceType:             data.Resource.ValueString(),
+		ResourceConfig:           resourceConfig,
+		TemplateVariables:        data.TemplateVariables.ValueStringPointer(),
+		PrioritizationMode:       prioritizationMode,
+		DefaultPriority:          runQueueDefaultPriority(data.DefaultPriority),
+		MaxConcurrentRuns:        data.MaxConcurrentRuns.ValueInt64Pointer(),
+		MaxConcurrentRunsPerUser: data.MaxConcurrentRunsPerUser.ValueInt64Pointer(),
+		Access:                   access,
+		ExternalLinks:            externalLinks,
 	}
 
 	result, err := upsertRunQueue(ctx, input, r.client)
@@ -184,6 +347,8 @@ func (r *RunQueueResource) Create(ctx context.Context, req resource.CreateReques
 	// Write logs using the tflog package
 	tflog.Trace(ctx, "created a run queue resource")
 
+	resp.Diagnostics.Append(r.client.recordInstance(ctx, resp.Private)...)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -194,6 +359,17 @@ func (r *RunQueueResource) Read(ctx context.Context, req resource.ReadRequest, r
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkInstance(ctx, resp.Private)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -216,7 +392,24 @@ func (r *RunQueueResource) Read(ctx context.Context, req resource.ReadRequest, r
 	data.Name = types.StringValue(runQueue.Name)
 	data.EntityName = types.StringValue(runQueue.EntityName)
 	data.Resource = types.StringValue(runQueue.DefaultResourceConfig.Resource)
-	data.PrioritizationMode = types.StringValue(runQueue.PrioritizationMode)
+	data.PrioritizationMode = stringPointerValueOrNull(&runQueue.PrioritizationMode)
+	data.DefaultPriority = types.StringNull()
+	if runQueue.DefaultPriority != nil {
+		defaultPriority, err := runQueuePriorityName(*runQueue.DefaultPriority)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading default priority", err.Error())
+			return
+		}
+		data.DefaultPriority = types.StringValue(defaultPriority)
+	}
+	data.MaxConcurrentRuns = types.Int64PointerValue(runQueue.MaxConcurrentRuns)
+	data.MaxConcurrentRunsPerUser = types.Int64PointerValue(runQueue.MaxConcurrentRunsPerUser)
+	access, diags := runQueueAccessToModel(runQueue.Access, data.Access)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Access = access
 	byteConfig, err := json.Marshal(runQueue.DefaultResourceConfig.Config)
 	if err != nil {
 		resp.Diagnostics.AddError("Error marshalling resource config", err.Error())
@@ -275,12 +468,24 @@ func (r *RunQueueResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	externalLinks, err := convertExternalLinksMapToInputType(data.ExternalLinks.Elements())
 	if err != nil {
 		resp.Diagnostics.AddError("Error converting external links", err.Error())
 		return
 	}
 
+	access, diags := runQueueAccessInput(ctx, data.Access)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	prioritizationMode := data.PrioritizationMode.ValueStringPointer()
 	if prioritizationMode == nil {
 		defaultPrioritizationMode := "V0"
@@ -304,14 +509,18 @@ func (r *RunQueueResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	input := UpsertRunQueueInput{
-		QueueName:          data.Name.ValueString(),
-		EntityName:         data.EntityName.ValueString(),
-		ProjectName:        "model-registry",
-		ResourceType:       data.Resource.ValueString(),
-		ResourceConfig:     resourceConfig,
-		TemplateVariables:  data.TemplateVariables.ValueStringPointer(),
-		PrioritizationMode: prioritizationMode,
-		ExternalLinks:      externalLinks,
+		QueueName:                data.Name.ValueString(),
+		EntityName:               data.EntityName.ValueString(),
+		ProjectName:              "model-registry",
+		ResourceType:             data.Resource.ValueString(),
+		ResourceConfig:           resourceConfig,
+		TemplateVariables:        data.TemplateVariables.ValueStringPointer(),
+		PrioritizationMode:       prioritizationMode,
+		DefaultPriority:          runQueueDefaultPriority(data.DefaultPriority),
+		MaxConcurrentRuns:        data.MaxConcurrentRuns.ValueInt64Pointer(),
+		MaxConcurrentRunsPerUser: data.MaxConcurrentRunsPerUser.ValueInt64Pointer(),
+		Access:                   access,
+		ExternalLinks:            externalLinks,
 	}
 
 	result, err := upsertRunQueue(ctx, input, r.client)
@@ -343,6 +552,13 @@ func (r *RunQueueResource) Delete(ctx context.Context, req resource.DeleteReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	entityName, queueName, err := parseCompositeID(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error parsing composite ID", err.Error())
@@ -406,8 +622,43 @@ func (r *RunQueueResource) ImportState(ctx context.Context, req resource.ImportS
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// upsertRunQueueOptionalInputs are the input fields of UpsertRunQueueInput that older W&B servers do
+// not support, with their GraphQL types.
+var upsertRunQueueOptionalInputs = []struct {
+	field       string
+	graphQLType string
+}{
+	{"prioritizationMode", "RunQueuePrioritizationMode"},
+	{"defaultPriority", "Int"},
+	{"maxConcurrentRuns", "Int"},
+	{"maxConcurrentRunsPerUser", "Int"},
+	{"access", "RunQueueAccessInput"},
+	{"externalLinks", "JSONString"},
+}
+
 func upsertRunQueue(ctx context.Context, input UpsertRunQueueInput, client *GraphQLClientWithHeaders) (UpsertRunQueueResponse, error) {
-	gqlReq := graphql.NewRequest(`
+	optionalValues := map[string]interface{}{
+		"prioritizationMode":       input.PrioritizationMode,
+		"defaultPriority":          input.DefaultPriority,
+		"maxConcurrentRuns":        input.MaxConcurrentRuns,
+		"maxConcurrentRunsPerUser": input.MaxConcurrentRunsPerUser,
+		"access":                   input.Access,
+		"externalLinks":            input.ExternalLinks,
+	}
+
+	// Input fields the server does not support are left out of the mutation. Configured attributes
+	// that need them are rejected when planning.
+	var declarations, arguments []string
+	var supported []string
+	for _, optional := range upsertRunQueueOptionalInputs {
+		if client.supportsField(ctx, "UpsertRunQueueInput", optional.field) {
+			declarations = append(declarations, fmt.Sprintf("$%s: %s,", optional.field, optional.graphQLType))
+			arguments = append(arguments, fmt.Sprintf("%s: $%s,", optional.field, optional.field))
+			supported = append(supported, optional.field)
+		}
+	}
+
+	gqlReq := graphql.NewRequest(fmt.Sprintf(`
 		mutation UpsertRunQueue(
 			$entityName: String!,
 			$projectName: String!,
@@ -415,8 +666,7 @@ func upsertRunQueue(ctx context.Context, input UpsertRunQueueInput, client *Grap
 			$resourceType: String!,
 			$resourceConfig: JSONString!,
 			$templateVariables: JSONString,
-			$prioritizationMode: RunQueuePrioritizationMode,
-			$externalLinks: JSONString,
+			%s
 		) { 
 			upsertRunQueue(input: {
 				entityName: $entityName,
@@ -425,14 +675,13 @@ func upsertRunQueue(ctx context.Context, input UpsertRunQueueInput, client *Grap
 				resourceType: $resourceType,
 				resourceConfig: $resourceConfig,
 				templateVariables: $templateVariables,
-				prioritizationMode: $prioritizationMode,
-				externalLinks: $externalLinks,
+				%s
 			}) {
 				success
 				configSchemaValidationErrors
 			}
 		}
-	`)
+	`, strings.Join(declarations, "\n"), strings.Join(arguments, "\n")))
 
 	gqlReq.Var("entityName", input.EntityName)
 	gqlReq.Var("projectName", "model-registry")
@@ -440,11 +689,99 @@ func upsertRunQueue(ctx context.Context, input UpsertRunQueueInput, client *Grap
 	gqlReq.Var("resourceType", input.ResourceType)
 	gqlReq.Var("resourceConfig", input.ResourceConfig)
 	gqlReq.Var("templateVariables", input.TemplateVariables)
-	gqlReq.Var("prioritizationMode", input.PrioritizationMode)
-	gqlReq.Var("externalLinks", input.ExternalLinks)
+	for _, field := range supported {
+		gqlReq.Var(field, optionalValues[field])
+	}
 
 	var result UpsertRunQueueResponse
 
 	err := client.Run(ctx, gqlReq, &result)
 	return result, err
 }
+
+// runQueueDefaultPriority converts the configured default priority to its API value.
+func runQueueDefaultPriority(defaultPriority types.String) *int {
+	if defaultPriority.IsNull() || defaultPriority.IsUnknown() {
+		return nil
+	}
+	priority, ok := runQueuePriorities[defaultPriority.ValueString()]
+	if !ok {
+		return nil
+	}
+	return &priority
+}
+
+// runQueueAccessInput converts the configured access restrictions to the API input. Removing the
+// access attribute sends empty restrictions, which opens the queue to the whole entity again.
+func runQueueAccessInput(ctx context.Context, access *RunQueueAccessModel) (*RunQueueAccess, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	input := &RunQueueAccess{
+		Users:           []string{},
+		Teams:           []string{},
+		ServiceAccounts: []string{},
+	}
+	if access == nil {
+		return input, diags
+	}
+
+	for _, set := range []struct {
+		value  types.Set
+		target *[]string
+	}{
+		{access.Users, &input.Users},
+		{access.Teams, &input.Teams},
+		{access.ServiceAccounts, &input.ServiceAccounts},
+	} {
+		if set.value.IsNull() {
+			continue
+		}
+		diags.Append(set.value.ElementsAs(ctx, set.target, false)...)
+	}
+	input.AdminOnlyConfig = access.AdminOnlyConfig.ValueBool()
+
+	return input, diags
+}
+
+// runQueueAccessToModel converts the access restrictions read from the API to the Terraform model,
+// keeping unset attributes from the prior state unset when the API reports no restriction for them.
+func runQueueAccessToModel(access *RunQueueAccess, prior *RunQueueAccessModel) (*RunQueueAccessModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if access == nil || (len(access.Users) == 0 && len(access.Teams) == 0 && len(access.ServiceAccounts) == 0 && !access.AdminOnlyConfig) {
+		if prior == nil {
+			return nil, diags
+		}
+		access = &RunQueueAccess{}
+	}
+	if prior == nil {
+		prior = &RunQueueAccessModel{
+			Users:           types.SetNull(types.StringType),
+			Teams:           types.SetNull(types.StringType),
+			ServiceAccounts: types.SetNull(types.StringType),
+			AdminOnlyConfig: types.BoolNull(),
+		}
+	}
+
+	toSet := func(values []string, prior types.Set) types.Set {
+		if len(values) == 0 && prior.IsNull() {
+			return prior
+		}
+		elements := make([]attr.Value, 0, len(values))
+		for _, value := range values {
+			elements = append(elements, types.StringValue(value))
+		}
+		set, setDiags := types.SetValue(types.StringType, elements)
+		diags.Append(setDiags...)
+		return set
+	}
+
+	model := &RunQueueAccessModel{
+		Users:           toSet(access.Users, prior.Users),
+		Teams:           toSet(access.Teams, prior.Teams),
+		ServiceAccounts: toSet(access.ServiceAccounts, prior.ServiceAccounts),
+		AdminOnlyConfig: prior.AdminOnlyConfig,
+	}
+	if access.AdminOnlyConfig || !prior.AdminOnlyConfig.IsNull() {
+		model.AdminOnlyConfig = types.BoolValue(access.AdminOnlyConfig)
+	}
+	return model, diags
+}
@@ -45,6 +45,11 @@ func TestAccRunQueueResource(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", "example-queue"),
 					resource.TestCheckResourceAttr(resourceName, "resource", "kubernetes"),
 					resource.TestCheckResourceAttr(resourceName, "prioritization_mode", "V0"),
+					resource.TestCheckResourceAttr(resourceName, "default_priority", "low"),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrent_runs", "4"),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrent_runs_per_user", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "access.teams.*", "terraform-acceptance-test"),
+					resource.TestCheckResourceAttr(resourceName, "access.admin_only_config", "true"),
 					resource.TestCheckResourceAttr(resourceName, "external_links.label", "https://example.com"),
 				),
 			},
@@ -146,7 +151,15 @@ resource "wandb_run_queue" "test" {
     }
   })
 
-  prioritization_mode = "V0"
+  prioritization_mode          = "V0"
+  default_priority             = "low"
+  max_concurrent_runs          = 4
+  max_concurrent_runs_per_user = 2
+
+  access = {
+    teams             = ["terraform-acceptance-test"]
+    admin_only_config = true
+  }
   external_links = {
     "label" : "https://example.com",
     "label2" : "https://example2.com"
@@ -161,5 +174,5 @@ func newGraphQLClient() *GraphQLClientWithHeaders {
 	headers := http.Header{}
 	headers.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("api:"+apiKey)))
 	headers.Set("Content-Type", "application/json")
-	return NewGraphQLClientWithHeaders(baseURL+"/graphql", headers)
+	return NewGraphQLClientWithHeaders(baseURL+"/graphql", headers, nil)
 }
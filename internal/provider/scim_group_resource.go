@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SCIMGroupResource{}
+var _ resource.ResourceWithConfigure = &SCIMGroupResource{}
+var _ resource.ResourceWithImportState = &SCIMGroupResource{}
+
+func NewSCIMGroupResource() resource.Resource {
+	return &SCIMGroupResource{}
+}
+
+type SCIMGroupResource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type SCIMGroupResourceModel struct {
+	Id          types.String   `tfsdk:"id"`
+	DisplayName types.String   `tfsdk:"display_name"`
+	Members     types.Set      `tfsdk:"members"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *SCIMGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "wandb_scim_group"
+}
+
+func (r *SCIMGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "SCIM group resource. Groups are provisioned as teams through the W&B SCIM API, the same way an identity provider provisions them. See: https://docs.wandb.ai/guides/hosting/iam/scim. See [here](https://github.com/wandb/terraform-provider-wandb/blob/main/examples/resources/scim_group/resource.tf) for an example",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The SCIM ID of the group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the group, which is also the name of the team. Changing this forces a new group to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"members": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The SCIM IDs of the users that are members of the group.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *SCIMGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SCIMGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SCIMGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var members []string
+	resp.Diagnostics.Append(data.Members.ElementsAs(ctx, &members, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group := SCIMGroup{
+		Schemas:     []string{scimGroupSchema},
+		DisplayName: data.DisplayName.ValueString(),
+		Members:     []SCIMGroupMember{},
+	}
+	for _, userID := range members {
+		group.Members = append(group.Members, SCIMGroupMember{Value: userID})
+	}
+
+	var result SCIMGroup
+	if err := r.client.RunSCIM(ctx, http.MethodPost, "/Groups", group, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating SCIM group",
+			"Could not create SCIM group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if result.ID == "" {
+		resp.Diagnostics.AddError(
+			"Failed to create SCIM group",
+			"The API did not return the created SCIM group.",
+		)
+		return
+	}
+
+	data.Id = types.StringValue(result.ID)
+
+	tflog.Trace(ctx, "created a SCIM group resource")
+
+	resp.Diagnostics.Append(r.client.recordInstance(ctx, resp.Private)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SCIMGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SCIMGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkInstance(ctx, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := readSCIMGroupHelper(ctx, data.Id.ValueString(), r.client)
+	if errors.Is(err, errSCIMGroupNotFound) {
+		tflog.Warn(ctx, "SCIM group no longer exists, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading SCIM group",
+			"Could not read SCIM group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.DisplayName = types.StringValue(group.DisplayName)
+	if !data.Members.IsNull() || len(group.Members) > 0 {
+		members := make([]string, 0, len(group.Members))
+		for _, member := range group.Members {
+			members = append(members, member.Value)
+		}
+		memberSet, diags := types.SetValueFrom(ctx, types.StringType, members)
+		resp.Diagnostics.Append(diags...)
+		data.Members = memberSet
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SCIMGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SCIMGroupResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current, desired []string
+	resp.Diagnostics.Append(state.Members.ElementsAs(ctx, &current, false)...)
+	resp.Diagnostics.Append(data.Members.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if operations := scimGroupMemberOperations(current, desired); len(operations) > 0 {
+		patch := SCIMPatchRequest{
+			Schemas:    []string{scimPatchOpSchema},
+			Operations: operations,
+		}
+
+		if err := r.client.RunSCIM(ctx, http.MethodPatch, "/Groups/"+url.PathEscape(data.Id.ValueString()), patch, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating SCIM group",
+				"Could not update SCIM group, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SCIMGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SCIMGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A group that was already deleted outside of Terraform is not an error.
+	if err := r.client.RunSCIM(ctx, http.MethodDelete, "/Groups/"+url.PathEscape(data.Id.ValueString()), nil, nil); err != nil && !isSCIMNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting SCIM group",
+			"Could not delete SCIM group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a SCIM group resource")
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *SCIMGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
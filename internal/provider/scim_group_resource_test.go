@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccSCIMGroupResource(t *testing.T) {
+	resourceName := "wandb_scim_group.test"
+	server := newSCIMTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckSCIMGroupResourceDestroy(server),
+		Steps: []resource.TestStep{
+			{
+				Config: server.providerConfig() + testAccSCIMGroupResourceConfig(`[wandb_scim_user.first.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "terraform-example"),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: server.providerConfig() + testAccSCIMGroupResourceConfig(`[wandb_scim_user.second.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "members.*", "wandb_scim_user.second", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSCIMGroupResourceDestroy(server *scimTestServer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+
+		for id := range server.groups {
+			return fmt.Errorf("scim group still exists: %s", id)
+		}
+		return nil
+	}
+}
+
+func testAccSCIMGroupResourceConfig(members string) string {
+	return fmt.Sprintf(`
+resource "wandb_scim_user" "first" {
+  user_name = "terraform-example-first"
+  email     = "terraform-example-first@example.com"
+}
+
+resource "wandb_scim_user" "second" {
+  user_name = "terraform-example-second"
+  email     = "terraform-example-second@example.com"
+}
+
+resource "wandb_scim_group" "test" {
+  display_name = "terraform-example"
+  members      = %s
+}
+`, members)
+}
+
+func TestSCIMGroupResourceRead_NotFound(t *testing.T) {
+	server := newSCIMTestServer(t)
+	r := &SCIMGroupResource{client: server.client(scimTestAPIKey)}
+
+	resp := testRead(r, testResourceState(t, r, map[string]tftypes.Value{
+		"id":           tftypes.NewValue(tftypes.String, "missing-group"),
+		"display_name": tftypes.NewValue(tftypes.String, "Example Group"),
+	}))
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
+
+func TestSCIMGroupResourceDelete_NotFound(t *testing.T) {
+	server := newSCIMTestServer(t)
+	r := &SCIMGroupResource{client: server.client(scimTestAPIKey)}
+
+	resp := testDelete(r, testResourceState(t, r, map[string]tftypes.Value{
+		"id":           tftypes.NewValue(tftypes.String, "missing-group"),
+		"display_name": tftypes.NewValue(tftypes.String, "Example Group"),
+	}))
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
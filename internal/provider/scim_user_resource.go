@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SCIMUserResource{}
+var _ resource.ResourceWithConfigure = &SCIMUserResource{}
+var _ resource.ResourceWithImportState = &SCIMUserResource{}
+
+func NewSCIMUserResource() resource.Resource {
+	return &SCIMUserResource{}
+}
+
+type SCIMUserResource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type SCIMUserResourceModel struct {
+	Id          types.String   `tfsdk:"id"`
+	UserName    types.String   `tfsdk:"user_name"`
+	Email       types.String   `tfsdk:"email"`
+	DisplayName types.String   `tfsdk:"display_name"`
+	Active      types.Bool     `tfsdk:"active"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *SCIMUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "wandb_scim_user"
+}
+
+func (r *SCIMUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "SCIM user resource. Users are provisioned through the W&B SCIM API, the same way an identity provider provisions them, so they can be managed alongside users pushed by the identity provider. Destroying the resource removes the user from the organization. See: https://docs.wandb.ai/guides/hosting/iam/scim. See [here](https://github.com/wandb/terraform-provider-wandb/blob/main/examples/resources/scim_user/resource.tf) for an example",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The SCIM ID of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The username of the user. Changing this forces a new user to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Required:    true,
+				Description: "The primary email address of the user. Changing this forces a new user to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The display name of the user.",
+			},
+			"active": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the user is active. Deactivated users cannot sign in. Defaults to true.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *SCIMUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SCIMUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SCIMUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user := SCIMUser{
+		Schemas:     []string{scimUserSchema},
+		UserName:    data.UserName.ValueString(),
+		DisplayName: data.DisplayName.ValueStringPointer(),
+		Emails:      []SCIMEmail{{Value: data.Email.ValueString(), Primary: true}},
+		Active:      data.Active.ValueBoolPointer(),
+	}
+
+	var result SCIMUser
+	if err := r.client.RunSCIM(ctx, http.MethodPost, "/Users", user, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating SCIM user",
+			"Could not create SCIM user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if result.ID == "" {
+		resp.Diagnostics.AddError(
+			"Failed to create SCIM user",
+			"The API did not return the created SCIM user.",
+		)
+		return
+	}
+
+	data.Id = types.StringValue(result.ID)
+
+	tflog.Trace(ctx, "created a SCIM user resource")
+
+	resp.Diagnostics.Append(r.client.recordInstance(ctx, resp.Private)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SCIMUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SCIMUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkInstance(ctx, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := readSCIMUserHelper(ctx, data.Id.ValueString(), r.client)
+	if errors.Is(err, errSCIMUserNotFound) {
+		tflog.Warn(ctx, "SCIM user no longer exists, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading SCIM user",
+			"Could not read SCIM user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.UserName = types.StringValue(user.UserName)
+	data.DisplayName = stringPointerValueOrNull(user.DisplayName)
+	data.Active = types.BoolValue(user.Active == nil || *user.Active)
+	for i, email := range user.Emails {
+		if email.Primary || i == 0 {
+			data.Email = types.StringValue(email.Value)
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SCIMUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SCIMUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	displayName := SCIMPatchOperation{Op: "remove", Path: "displayName"}
+	if !data.DisplayName.IsNull() {
+		displayName = SCIMPatchOperation{Op: "replace", Path: "displayName", Value: data.DisplayName.ValueString()}
+	}
+	patch := SCIMPatchRequest{
+		Schemas: []string{scimPatchOpSchema},
+		Operations: []SCIMPatchOperation{
+			displayName,
+			{Op: "replace", Path: "active", Value: data.Active.ValueBool()},
+		},
+	}
+
+	if err := r.client.RunSCIM(ctx, http.MethodPatch, "/Users/"+url.PathEscape(data.Id.ValueString()), patch, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating SCIM user",
+			"Could not update SCIM user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SCIMUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SCIMUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A user that was already deleted outside of Terraform is not an error.
+	if err := r.client.RunSCIM(ctx, http.MethodDelete, "/Users/"+url.PathEscape(data.Id.ValueString()), nil, nil); err != nil && !isSCIMNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting SCIM user",
+			"Could not delete SCIM user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a SCIM user resource")
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *SCIMUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
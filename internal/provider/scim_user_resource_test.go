@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccSCIMUserResource(t *testing.T) {
+	resourceName := "wandb_scim_user.test"
+	server := newSCIMTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckSCIMUserResourceDestroy(server),
+		Steps: []resource.TestStep{
+			{
+				Config: server.providerConfig() + testAccSCIMUserResourceConfig("Example User", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "email", "terraform-example@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Example User"),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: server.providerConfig() + testAccSCIMUserResourceConfig("Renamed User", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Renamed User"),
+					resource.TestCheckResourceAttr(resourceName, "active", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSCIMUserResourceDestroy(server *scimTestServer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+
+		for id := range server.users {
+			return fmt.Errorf("scim user still exists: %s", id)
+		}
+		return nil
+	}
+}
+
+func testAccSCIMUserResourceConfig(displayName string, active bool) string {
+	return fmt.Sprintf(`
+resource "wandb_scim_user" "test" {
+  user_name    = "terraform-example"
+  email        = "terraform-example@example.com"
+  display_name = %q
+  active       = %t
+}
+`, displayName, active)
+}
+
+func TestSCIMUserResourceRead_NotFound(t *testing.T) {
+	server := newSCIMTestServer(t)
+	r := &SCIMUserResource{client: server.client(scimTestAPIKey)}
+
+	resp := testRead(r, testResourceState(t, r, map[string]tftypes.Value{
+		"id":        tftypes.NewValue(tftypes.String, "missing-user"),
+		"user_name": tftypes.NewValue(tftypes.String, "terraform-example"),
+	}))
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
+
+func TestSCIMUserResourceDelete_NotFound(t *testing.T) {
+	server := newSCIMTestServer(t)
+	r := &SCIMUserResource{client: server.client(scimTestAPIKey)}
+
+	resp := testDelete(r, testResourceState(t, r, map[string]tftypes.Value{
+		"id":        tftypes.NewValue(tftypes.String, "missing-user"),
+		"user_name": tftypes.NewValue(tftypes.String, "terraform-example"),
+	}))
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
@@ -0,0 +1,342 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/machinebox/graphql"
+)
+
+const slackIntegrationTypename = "SlackIntegration"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SlackIntegrationResource{}
+var _ resource.ResourceWithConfigure = &SlackIntegrationResource{}
+var _ resource.ResourceWithImportState = &SlackIntegrationResource{}
+var _ resource.ResourceWithModifyPlan = &SlackIntegrationResource{}
+
+func NewSlackIntegrationResource() resource.Resource {
+	return &SlackIntegrationResource{}
+}
+
+type SlackIntegrationResource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type SlackIntegrationResourceModel struct {
+	Id            types.String   `tfsdk:"id"`
+	IntegrationId types.String   `tfsdk:"integration_id"`
+	EntityName    types.String   `tfsdk:"entity_name"`
+	Code          types.String   `tfsdk:"code"`
+	RedirectUri   types.String   `tfsdk:"redirect_uri"`
+	TeamName      types.String   `tfsdk:"team_name"`
+	ChannelName   types.String   `tfsdk:"channel_name"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// requiresReplaceUnlessImported forces replacement when a create-only value changes, except when the
+// resource was imported and the value was never known.
+var requiresReplaceUnlessImported = stringplanmodifier.RequiresReplaceIf(
+	func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+		resp.RequiresReplace = !req.StateValue.IsNull()
+	},
+	"Changing this value forces a new resource to be created, unless the resource was imported.",
+	"Changing this value forces a new resource to be created, unless the resource was imported.",
+)
+
+func (r *SlackIntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "wandb_slack_integration"
+}
+
+func (r *SlackIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Slack integration resource that registers a Slack channel as a notification destination for an entity. The channel is chosen when authorizing the W&B Slack app, which returns the OAuth code used here. See [here](https://github.com/wandb/terraform-provider-wandb/blob/main/examples/resources/slack_integration/resource.tf) for an example",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the Slack integration resource. This is a composite ID of the entity name and the integration ID, separated by a ':'",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"integration_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the Slack integration, used as the integration_id of wandb_automation notification actions.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"entity_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the entity the Slack channel is registered for. Defaults to the provider's default_entity.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"code": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The OAuth authorization code returned by Slack when installing the W&B app into a channel. Required on create. The code is single-use and is not read back from the API.",
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessImported,
+				},
+			},
+			"redirect_uri": schema.StringAttribute{
+				Optional:    true,
+				Description: "The redirect URI used when requesting the OAuth authorization code. Required on create.",
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessImported,
+				},
+			},
+			"team_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the Slack workspace.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the Slack channel notifications are posted to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *SlackIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SlackIntegrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		return
+	}
+
+	r.client.applyProviderDefaults(ctx, req, resp, "entity_name")
+}
+
+func (r *SlackIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SlackIntegrationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Code.IsNull() || data.RedirectUri.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing Slack authorization",
+			"code and redirect_uri must be specified to create a Slack integration.",
+		)
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation CreateSlackIntegration($entityName: String!, $code: String!, $redirectURI: String!) {
+			createSlackIntegration(input: {entityName: $entityName, code: $code, redirectURI: $redirectURI}) {
+				integration {
+					... on SlackIntegration {
+						id
+						teamName
+						channelName
+					}
+				}
+			}
+		}
+	`)
+	gqlReq.Var("entityName", data.EntityName.ValueString())
+	gqlReq.Var("code", data.Code.ValueString())
+	gqlReq.Var("redirectURI", data.RedirectUri.ValueString())
+
+	var result struct {
+		CreateSlackIntegration struct {
+			Integration *Integration `json:"integration"`
+		} `json:"createSlackIntegration"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Slack integration",
+			"Could not create Slack integration, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	integration := result.CreateSlackIntegration.Integration
+	if integration == nil {
+		resp.Diagnostics.AddError(
+			"Failed to create Slack integration",
+			"The API did not return the created Slack integration.",
+		)
+		return
+	}
+
+	data.Id = types.StringValue(generateCompositeID(data.EntityName.ValueString(), integration.ID))
+	data.IntegrationId = types.StringValue(integration.ID)
+	data.TeamName = types.StringPointerValue(integration.TeamName)
+	data.ChannelName = types.StringPointerValue(integration.ChannelName)
+
+	tflog.Trace(ctx, "created a Slack integration resource")
+
+	resp.Diagnostics.Append(r.client.recordInstance(ctx, resp.Private)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SlackIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SlackIntegrationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkInstance(ctx, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	entityName, integrationID, err := parseCompositeID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing composite ID", err.Error())
+		return
+	}
+
+	integration, err := readIntegrationHelper(ctx, entityName, integrationID, slackIntegrationTypename, r.client)
+	if errors.Is(err, errIntegrationNotFound) {
+		tflog.Warn(ctx, "Slack integration no longer exists, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Slack integration",
+			"Could not read Slack integration, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// The OAuth code and redirect URI are only used on create, so only the API fields are refreshed.
+	data.IntegrationId = types.StringValue(integration.ID)
+	data.EntityName = types.StringValue(entityName)
+	data.TeamName = types.StringPointerValue(integration.TeamName)
+	data.ChannelName = types.StringPointerValue(integration.ChannelName)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SlackIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SlackIntegrationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every attribute either forces replacement or is computed, so an update only occurs when code or
+	// redirect_uri are set on an imported integration and only the new values need to be stored.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SlackIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SlackIntegrationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	success, err := deleteIntegration(ctx, data.IntegrationId.ValueString(), r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Slack integration",
+			"Could not delete Slack integration, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !success {
+		resp.Diagnostics.AddError(
+			"Failed to delete Slack integration",
+			"The API did not confirm the deletion of the Slack integration.",
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a Slack integration resource")
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *SlackIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
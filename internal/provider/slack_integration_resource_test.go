@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSlackIntegrationResource(t *testing.T) {
+	resourceName := "wandb_slack_integration.test"
+
+	// Slack OAuth codes are single-use, so a fresh code must be supplied for each run.
+	code := os.Getenv("WANDB_SLACK_OAUTH_CODE")
+	redirectURI := os.Getenv("WANDB_SLACK_REDIRECT_URI")
+	if code == "" || redirectURI == "" {
+		t.Skip("WANDB_SLACK_OAUTH_CODE and WANDB_SLACK_REDIRECT_URI must be set to test Slack integrations")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckSlackIntegrationResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackIntegrationResourceConfig(code, redirectURI),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "integration_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "channel_name"),
+					resource.TestCheckResourceAttrPair("data.wandb_slack_integrations.test", "integrations.0.id", resourceName, "integration_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"code", "redirect_uri"},
+			},
+		},
+	})
+}
+
+func testAccCheckSlackIntegrationResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "wandb_slack_integration" {
+			continue
+		}
+
+		_, err := readIntegrationHelper(context.Background(), rs.Primary.Attributes["entity_name"], rs.Primary.Attributes["integration_id"], slackIntegrationTypename, newGraphQLClient())
+		if err == nil {
+			return fmt.Errorf("Slack integration still exists: %s", rs.Primary.ID)
+		}
+		if !errors.Is(err, errIntegrationNotFound) {
+			return fmt.Errorf("checking that Slack integration %s was destroyed: %w", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccSlackIntegrationResourceConfig(code, redirectURI string) string {
+	return fmt.Sprintf(`
+resource "wandb_slack_integration" "test" {
+  entity_name  = "terraform-acceptance-test"
+  code         = %q
+  redirect_uri = %q
+}
+
+data "wandb_slack_integrations" "test" {
+  entity_name  = wandb_slack_integration.test.entity_name
+  channel_name = wandb_slack_integration.test.channel_name
+}
+`, code, redirectURI)
+}
+
+func TestSlackIntegrationResourceRead_NotFound(t *testing.T) {
+	client, _ := newIntrospectionTestServer(t, nil, `{"data": {"entity": {"integrations": {"edges": []}}}}`)
+	r := &SlackIntegrationResource{client: client}
+
+	resp := testRead(r, testResourceState(t, r, map[string]tftypes.Value{
+		"id":             tftypes.NewValue(tftypes.String, "terraform-acceptance-test:integration-1"),
+		"integration_id": tftypes.NewValue(tftypes.String, "integration-1"),
+		"entity_name":    tftypes.NewValue(tftypes.String, "terraform-acceptance-test"),
+	}))
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SlackIntegrationsDataSource{}
+var _ datasource.DataSourceWithConfigure = &SlackIntegrationsDataSource{}
+
+func NewSlackIntegrationsDataSource() datasource.DataSource {
+	return &SlackIntegrationsDataSource{}
+}
+
+type SlackIntegrationsDataSource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type SlackIntegrationsDataSourceModel struct {
+	Id           types.String            `tfsdk:"id"`
+	EntityName   types.String            `tfsdk:"entity_name"`
+	ChannelName  types.String            `tfsdk:"channel_name"`
+	Integrations []SlackIntegrationModel `tfsdk:"integrations"`
+}
+
+type SlackIntegrationModel struct {
+	Id          types.String `tfsdk:"id"`
+	TeamName    types.String `tfsdk:"team_name"`
+	ChannelName types.String `tfsdk:"channel_name"`
+}
+
+func (d *SlackIntegrationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "wandb_slack_integrations"
+}
+
+func (d *SlackIntegrationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Slack integrations configured for an entity, so their IDs can be used as the integration_id of wandb_automation notification actions.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the entity.",
+			},
+			"entity_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the entity to list Slack integrations for. Defaults to the provider's default_entity.",
+			},
+			"channel_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "If set, only integrations posting to this Slack channel are returned.",
+			},
+			"integrations": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The Slack integrations configured for the entity.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Slack integration.",
+						},
+						"team_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the Slack workspace.",
+						},
+						"channel_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the Slack channel notifications are posted to.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SlackIntegrationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SlackIntegrationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SlackIntegrationsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.EntityName.IsNull() {
+		if d.client.defaultEntity == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("entity_name"),
+				"Missing entity_name",
+				"Set entity_name or configure default_entity on the provider.",
+			)
+			return
+		}
+		data.EntityName = types.StringValue(d.client.defaultEntity)
+	}
+
+	integrations, err := readIntegrationsHelper(ctx, data.EntityName.ValueString(), d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Slack integrations",
+			"Could not read Slack integrations, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Integrations = []SlackIntegrationModel{}
+	for _, integration := range integrations {
+		if integration.Typename != slackIntegrationTypename {
+			continue
+		}
+		if !data.ChannelName.IsNull() && (integration.ChannelName == nil || *integration.ChannelName != data.ChannelName.ValueString()) {
+			continue
+		}
+		data.Integrations = append(data.Integrations, SlackIntegrationModel{
+			Id:          types.StringValue(integration.ID),
+			TeamName:    types.StringPointerValue(integration.TeamName),
+			ChannelName: types.StringPointerValue(integration.ChannelName),
+		})
+	}
+	data.Id = data.EntityName
+
+	tflog.Trace(ctx, "read a Slack integrations data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
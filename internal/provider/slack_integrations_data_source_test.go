@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSlackIntegrationsDataSource(t *testing.T) {
+	dataSourceName := "data.wandb_slack_integrations.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackIntegrationsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "terraform-acceptance-test"),
+					resource.TestCheckResourceAttr(dataSourceName, "integrations.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSlackIntegrationsDataSourceConfig() string {
+	return `
+data "wandb_slack_integrations" "test" {
+  entity_name  = "terraform-acceptance-test"
+  channel_name = "terraform-acceptance-nonexistent-channel"
+}
+`
+}
@@ -0,0 +1,366 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/machinebox/graphql"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StorageBucketResource{}
+var _ resource.ResourceWithConfigure = &StorageBucketResource{}
+var _ resource.ResourceWithImportState = &StorageBucketResource{}
+var _ resource.ResourceWithValidateConfig = &StorageBucketResource{}
+var _ resource.ResourceWithModifyPlan = &StorageBucketResource{}
+
+func NewStorageBucketResource() resource.Resource {
+	return &StorageBucketResource{}
+}
+
+type StorageBucketResource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type StorageBucketResourceModel struct {
+	Id         types.String   `tfsdk:"id"`
+	EntityName types.String   `tfsdk:"entity_name"`
+	Provider   types.String   `tfsdk:"provider_type"`
+	Bucket     types.String   `tfsdk:"bucket"`
+	PathPrefix types.String   `tfsdk:"path_prefix"`
+	KmsKeyId   types.String   `tfsdk:"kms_key_id"`
+	Region     types.String   `tfsdk:"region"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *StorageBucketResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "wandb_storage_bucket"
+}
+
+func (r *StorageBucketResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Storage bucket resource that configures bring-your-own-bucket (BYOB) storage for a team, so the team's artifacts and run files are stored in a bucket it owns. W&B checks that it can access the bucket before the settings are saved. See: https://docs.wandb.ai/guides/hosting/data-security/secure-storage-connector. See [here](https://github.com/wandb/terraform-provider-wandb/blob/main/examples/resources/storage_bucket/resource.tf) for an example",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the storage bucket resource. This is the name of the team.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"entity_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the team that stores its data in the bucket. Defaults to the provider's default_entity.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"provider_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The cloud storage provider. Options include: s3, gcs and azure.",
+			},
+			"bucket": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the bucket. For azure, this is the storage account and container in the form account/container.",
+			},
+			"path_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path within the bucket that W&B stores data under.",
+			},
+			"kms_key_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the KMS key used to encrypt data in the bucket.",
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "The region of the bucket, for example us-east-1.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *StorageBucketResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data StorageBucketResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Provider.IsNull() || data.Provider.IsUnknown() {
+		return
+	}
+
+	if _, ok := storageBucketProviders[data.Provider.ValueString()]; !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("provider_type"),
+			"Invalid storage provider",
+			"provider_type must be one of: s3, gcs, azure.",
+		)
+	}
+}
+
+func (r *StorageBucketResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(client.requireServerSupport(ctx, "wandb_storage_bucket", "Entity", "storageBucketInfo")...)
+	r.client = client
+}
+
+func (r *StorageBucketResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		return
+	}
+
+	r.client.applyProviderDefaults(ctx, req, resp, "entity_name")
+}
+
+func (r *StorageBucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data StorageBucketResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.saveStorageBucket(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = data.EntityName
+
+	tflog.Trace(ctx, "created a storage bucket resource")
+
+	resp.Diagnostics.Append(r.client.recordInstance(ctx, resp.Private)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageBucketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StorageBucketResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkInstance(ctx, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, err := readStorageBucketHelper(ctx, data.Id.ValueString(), r.client)
+	if errors.Is(err, errStorageBucketNotFound) {
+		tflog.Warn(ctx, "storage bucket no longer configured, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading storage bucket",
+			"Could not read storage bucket, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	provider, err := storageBucketProviderFromAPI(bucket.Provider)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading storage provider", err.Error())
+		return
+	}
+
+	data.EntityName = data.Id
+	data.Provider = types.StringValue(provider)
+	data.Bucket = types.StringValue(bucket.Name)
+	data.PathPrefix = stringPointerValueOrNull(bucket.Path)
+	data.KmsKeyId = stringPointerValueOrNull(bucket.KmsKeyID)
+	data.Region = stringPointerValueOrNull(bucket.Region)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageBucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data StorageBucketResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.saveStorageBucket(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageBucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data StorageBucketResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Data already written to the bucket stays there, so the team keeps its storage settings and the
+	// bucket is only removed from Terraform state.
+	resp.Diagnostics.AddWarning(
+		"Storage bucket left in place",
+		"The storage bucket of team "+data.EntityName.ValueString()+" cannot be removed through the API and has only been removed from Terraform state.",
+	)
+
+	tflog.Trace(ctx, "deleted a storage bucket resource")
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *StorageBucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// saveStorageBucket checks that W&B can access the configured bucket and, if it can, saves it as the
+// team's storage bucket.
+func (r *StorageBucketResource) saveStorageBucket(ctx context.Context, data *StorageBucketResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket := StorageBucketInfo{
+		Provider: storageBucketProviders[data.Provider.ValueString()],
+		Name:     data.Bucket.ValueString(),
+		Path:     data.PathPrefix.ValueStringPointer(),
+		KmsKeyID: data.KmsKeyId.ValueStringPointer(),
+		Region:   data.Region.ValueStringPointer(),
+	}
+
+	checks, err := testStorageBucketHelper(ctx, bucket, r.client)
+	if err != nil {
+		diags.AddError(
+			"Error validating storage bucket",
+			"Could not validate storage bucket, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	for _, check := range checks {
+		if check.Severity == "ERROR" {
+			diags.AddAttributeError(path.Root("bucket"), "Storage bucket validation failed", check.Message)
+		} else {
+			diags.AddAttributeWarning(path.Root("bucket"), "Storage bucket validation warning", check.Message)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation UpdateEntityStorageBucket($entityName: String!, $storageBucketInfo: StorageBucketInfoInput!) {
+			updateEntity(input: {entity: $entityName, storageBucketInfo: $storageBucketInfo}) {
+				entity {
+					id
+				}
+			}
+		}
+	`)
+	gqlReq.Var("entityName", data.EntityName.ValueString())
+	gqlReq.Var("storageBucketInfo", bucket)
+
+	var result struct {
+		UpdateEntity struct {
+			Entity *struct {
+				ID string `json:"id"`
+			} `json:"entity"`
+		} `json:"updateEntity"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		diags.AddError(
+			"Error saving storage bucket",
+			"Could not save storage bucket, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	if result.UpdateEntity.Entity == nil {
+		diags.AddError(
+			"Failed to save storage bucket",
+			"The API did not confirm the update of the team's storage bucket.",
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccStorageBucketResource(t *testing.T) {
+	resourceName := "wandb_storage_bucket.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketResourceConfig("terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "terraform-acceptance-test"),
+					resource.TestCheckResourceAttr(resourceName, "provider_type", "s3"),
+					resource.TestCheckResourceAttr(resourceName, "path_prefix", "terraform"),
+					testAccCheckStorageBucketPathPrefix("terraform-acceptance-test", "terraform"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStorageBucketResourceConfig("terraform-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path_prefix", "terraform-updated"),
+					testAccCheckStorageBucketPathPrefix("terraform-acceptance-test", "terraform-updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStorageBucketPathPrefix(entityName, pathPrefix string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		bucket, err := readStorageBucketHelper(context.Background(), entityName, newGraphQLClient())
+		if err != nil {
+			return err
+		}
+		if bucket.Path == nil || *bucket.Path != pathPrefix {
+			return fmt.Errorf("storage bucket path prefix is not %s", pathPrefix)
+		}
+		return nil
+	}
+}
+
+func testAccStorageBucketResourceConfig(pathPrefix string) string {
+	return fmt.Sprintf(`
+resource "wandb_storage_bucket" "test" {
+  entity_name   = "terraform-acceptance-test"
+  provider_type = "s3"
+  bucket        = "terraform-acceptance-test-wandb"
+  path_prefix   = %q
+  region        = "us-east-1"
+}
+`, pathPrefix)
+}
+
+func TestStorageBucketResourceRead_NotFound(t *testing.T) {
+	client, _ := newIntrospectionTestServer(t, nil, `{"data": {"entity": {"storageBucketInfo": null}}}`)
+	r := &StorageBucketResource{client: client}
+
+	resp := testRead(r, testResourceState(t, r, map[string]tftypes.Value{
+		"id":            tftypes.NewValue(tftypes.String, "terraform-acceptance-test"),
+		"entity_name":   tftypes.NewValue(tftypes.String, "terraform-acceptance-test"),
+		"provider_type": tftypes.NewValue(tftypes.String, "aws"),
+		"bucket":        tftypes.NewValue(tftypes.String, "example-bucket"),
+	}))
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
@@ -0,0 +1,770 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/machinebox/graphql"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SweepResource{}
+var _ resource.ResourceWithConfigure = &SweepResource{}
+var _ resource.ResourceWithImportState = &SweepResource{}
+var _ resource.ResourceWithValidateConfig = &SweepResource{}
+var _ resource.ResourceWithModifyPlan = &SweepResource{}
+
+// requiresReplaceWhenFinished forces a new sweep to be created when a finished sweep is configured to
+// run again, since a finished sweep cannot be resumed. This includes sweeps that finished on the server.
+var requiresReplaceWhenFinished = stringplanmodifier.RequiresReplaceIf(
+	func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+		resp.RequiresReplace = req.StateValue.ValueString() == "finished" && req.PlanValue.ValueString() != "finished"
+	},
+	"Changing the state of a finished sweep forces a new sweep to be created.",
+	"Changing the state of a finished sweep forces a new sweep to be created.",
+)
+
+func NewSweepResource() resource.Resource {
+	return &SweepResource{}
+}
+
+type SweepResource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type SweepResourceModel struct {
+	Id             types.String                   `tfsdk:"id"`
+	SweepId        types.String                   `tfsdk:"sweep_id"`
+	EntityName     types.String                   `tfsdk:"entity_name"`
+	ProjectName    types.String                   `tfsdk:"project_name"`
+	Description    types.String                   `tfsdk:"description"`
+	Method         types.String                   `tfsdk:"method"`
+	Metric         *SweepMetricModel              `tfsdk:"metric"`
+	Parameters     map[string]SweepParameterModel `tfsdk:"parameters"`
+	EarlyTerminate *SweepEarlyTerminateModel      `tfsdk:"early_terminate"`
+	Program        types.String                   `tfsdk:"program"`
+	Job            types.String                   `tfsdk:"job"`
+	ConfigYaml     types.String                   `tfsdk:"config_yaml"`
+	QueueId        types.String                   `tfsdk:"queue_id"`
+	State          types.String                   `tfsdk:"state"`
+	Timeouts       timeouts.Value                 `tfsdk:"timeouts"`
+}
+
+type SweepMetricModel struct {
+	Name types.String `tfsdk:"name"`
+	Goal types.String `tfsdk:"goal"`
+}
+
+type SweepParameterModel struct {
+	Value        types.String   `tfsdk:"value"`
+	Values       []types.String `tfsdk:"values"`
+	Distribution types.String   `tfsdk:"distribution"`
+	Min          types.Float64  `tfsdk:"min"`
+	Max          types.Float64  `tfsdk:"max"`
+}
+
+type SweepEarlyTerminateModel struct {
+	Type    types.String `tfsdk:"type"`
+	MinIter types.Int64  `tfsdk:"min_iter"`
+	MaxIter types.Int64  `tfsdk:"max_iter"`
+	Eta     types.Int64  `tfsdk:"eta"`
+	S       types.Int64  `tfsdk:"s"`
+}
+
+func (r *SweepResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "wandb_sweep"
+}
+
+func (r *SweepResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sweep resource for hyperparameter searches. The sweep config can be declared with typed attributes or supplied as raw YAML, and the sweep can be bound to a run queue so its runs are launched by a W&B Launch scheduler. See: https://docs.wandb.ai/guides/sweeps. See [here](https://github.com/wandb/terraform-provider-wandb/blob/main/examples/resources/sweep/resource.tf) for an example",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the sweep resource. This is a composite ID of the entity name, project name and sweep ID, separated by a ':'",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sweep_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the sweep, as passed to `wandb agent`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"entity_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the entity that owns the sweep. Defaults to the provider's default_entity.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the project the sweep belongs to. Defaults to the provider's default_project.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "The description of the sweep.",
+			},
+			"method": schema.StringAttribute{
+				Optional:    true,
+				Description: "The search strategy. Options include: grid, random and bayes. Exactly one of method or config_yaml must be specified.",
+			},
+			"metric": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The metric to optimize.",
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required:    true,
+						Description: "The name of the metric.",
+					},
+					"goal": schema.StringAttribute{
+						Optional:    true,
+						Description: "Whether to minimize or maximize the metric. Options include: minimize and maximize. Defaults to minimize.",
+					},
+				},
+			},
+			"parameters": schema.MapNestedAttribute{
+				Optional:    true,
+				Description: "The hyperparameters to search, keyed by parameter name. Values that are valid JSON, such as numbers and booleans, are passed to the sweep with their JSON type.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							Optional:    true,
+							Description: "A constant value for the parameter.",
+						},
+						"values": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "The discrete values to search.",
+						},
+						"distribution": schema.StringAttribute{
+							Optional:    true,
+							Description: "The distribution to sample from, for example uniform or log_uniform_values.",
+						},
+						"min": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The minimum value of the distribution.",
+						},
+						"max": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The maximum value of the distribution.",
+						},
+					},
+				},
+			},
+			"early_terminate": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The early termination policy for poorly performing runs.",
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Required:    true,
+						Description: "The early termination algorithm. Options include: hyperband.",
+					},
+					"min_iter": schema.Int64Attribute{
+						Optional:    true,
+						Description: "The iteration of the first bracket.",
+					},
+					"max_iter": schema.Int64Attribute{
+						Optional:    true,
+						Description: "The maximum number of iterations.",
+					},
+					"eta": schema.Int64Attribute{
+						Optional:    true,
+						Description: "The bracket multiplier schedule.",
+					},
+					"s": schema.Int64Attribute{
+						Optional:    true,
+						Description: "The total number of brackets.",
+					},
+				},
+			},
+			"program": schema.StringAttribute{
+				Optional:    true,
+				Description: "The training script run by sweep agents.",
+			},
+			"job": schema.StringAttribute{
+				Optional:    true,
+				Description: "The launch job run for each trial, in the form entity/project/job-name:alias. Used with queue_id.",
+			},
+			"config_yaml": schema.StringAttribute{
+				Optional:    true,
+				Description: "The raw sweep config as YAML. Exactly one of method or config_yaml must be specified.",
+			},
+			"queue_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of a wandb_run_queue that a launch scheduler uses to run the sweep's trials.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("running"),
+				Description: "The state of the sweep. Options include: running, paused and finished. Defaults to running. A finished sweep cannot be resumed, so changing the state of a finished sweep forces a new sweep to be created.",
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceWhenFinished,
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *SweepResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SweepResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ConfigYaml.IsUnknown() || data.Method.IsUnknown() {
+		return
+	}
+
+	if data.ConfigYaml.IsNull() == data.Method.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid sweep config",
+			"Exactly one of method or config_yaml must be specified.",
+		)
+		return
+	}
+
+	if !data.ConfigYaml.IsNull() {
+		if data.Metric != nil || data.Parameters != nil || data.EarlyTerminate != nil || !data.Program.IsNull() || !data.Job.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("config_yaml"),
+				"Invalid sweep config",
+				"metric, parameters, early_terminate, program and job cannot be combined with config_yaml.",
+			)
+		}
+		if err := validateSweepConfigYAML(data.ConfigYaml.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("config_yaml"),
+				"Invalid sweep config",
+				"config_yaml must be a YAML mapping: "+err.Error(),
+			)
+		}
+	}
+
+	if method := data.Method.ValueString(); !data.Method.IsNull() && method != "grid" && method != "random" && method != "bayes" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("method"),
+			"Invalid sweep method",
+			"method must be one of: grid, random, bayes.",
+		)
+	}
+
+	if data.Metric != nil && !data.Metric.Goal.IsNull() && !data.Metric.Goal.IsUnknown() {
+		if goal := data.Metric.Goal.ValueString(); goal != "minimize" && goal != "maximize" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("metric").AtName("goal"),
+				"Invalid sweep metric goal",
+				"metric.goal must be one of: minimize, maximize.",
+			)
+		}
+	}
+
+	if data.EarlyTerminate != nil && !data.EarlyTerminate.Type.IsUnknown() && data.EarlyTerminate.Type.ValueString() != "hyperband" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("early_terminate").AtName("type"),
+			"Invalid sweep early termination type",
+			"early_terminate.type must be hyperband.",
+		)
+	}
+
+	if !data.State.IsNull() && !data.State.IsUnknown() {
+		if _, ok := sweepStates[data.State.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("state"),
+				"Invalid sweep state",
+				"state must be one of: running, paused, finished.",
+			)
+		}
+	}
+}
+
+func (r *SweepResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SweepResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		return
+	}
+
+	r.client.applyProviderDefaults(ctx, req, resp, "entity_name", "project_name")
+}
+
+func (r *SweepResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SweepResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, diags := sweepConfig(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := sweepStates[data.State.ValueString()]
+
+	var launchScheduler *string
+	if !data.QueueId.IsNull() {
+		queueEntityName, queueName, err := parseCompositeID(data.QueueId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error parsing run queue ID", err.Error())
+			return
+		}
+		runQueue, err := readRunQueueHelper(queueEntityName, queueName, ctx, *r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading run queue",
+				"Could not read run queue for sweep, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		schedulerBytes, err := json.Marshal(map[string]interface{}{
+			"queue":    runQueue.Name,
+			"resource": runQueue.DefaultResourceConfig.Resource,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error encoding launch scheduler", err.Error())
+			return
+		}
+		scheduler := string(schedulerBytes)
+		launchScheduler = &scheduler
+
+		// Launch sweeps start pending and are moved to running by the scheduler.
+		if state == "RUNNING" {
+			state = "PENDING"
+		}
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation UpsertSweep(
+			$entityName: String!,
+			$projectName: String!,
+			$config: String!,
+			$description: String,
+			$state: String,
+			$launchScheduler: JSONString,
+		) {
+			upsertSweep(input: {
+				entityName: $entityName,
+				projectName: $projectName,
+				config: $config,
+				description: $description,
+				state: $state,
+				launchScheduler: $launchScheduler,
+			}) {
+				sweep {
+					id
+					name
+				}
+			}
+		}
+	`)
+	gqlReq.Var("entityName", data.EntityName.ValueString())
+	gqlReq.Var("projectName", data.ProjectName.ValueString())
+	gqlReq.Var("config", config)
+	gqlReq.Var("description", data.Description.ValueStringPointer())
+	gqlReq.Var("state", state)
+	gqlReq.Var("launchScheduler", launchScheduler)
+
+	var result struct {
+		UpsertSweep struct {
+			Sweep *Sweep `json:"sweep"`
+		} `json:"upsertSweep"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating sweep",
+			"Could not create sweep, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if result.UpsertSweep.Sweep == nil {
+		resp.Diagnostics.AddError(
+			"Failed to create sweep",
+			"The API did not return the created sweep.",
+		)
+		return
+	}
+
+	sweepName := result.UpsertSweep.Sweep.Name
+	data.SweepId = types.StringValue(sweepName)
+	data.Id = types.StringValue(generateSweepID(data.EntityName.ValueString(), data.ProjectName.ValueString(), sweepName))
+
+	tflog.Trace(ctx, "created a sweep resource")
+
+	resp.Diagnostics.Append(r.client.recordInstance(ctx, resp.Private)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SweepResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SweepResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkInstance(ctx, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	entityName, projectName, sweepName, err := parseSweepID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing composite ID", err.Error())
+		return
+	}
+
+	sweep, err := readSweepHelper(ctx, entityName, projectName, sweepName, r.client)
+	if errors.Is(err, errSweepNotFound) {
+		tflog.Warn(ctx, "sweep no longer exists, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading sweep",
+			"Could not read sweep, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, err := sweepStateFromAPI(sweep.State)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading sweep state", err.Error())
+		return
+	}
+
+	data.SweepId = types.StringValue(sweep.Name)
+	data.EntityName = types.StringValue(entityName)
+	data.ProjectName = types.StringValue(projectName)
+	data.State = types.StringValue(state)
+	if !data.Description.IsNull() || (sweep.Description != nil && *sweep.Description != "") {
+		data.Description = types.StringPointerValue(sweep.Description)
+	}
+	// The API reformats the config, so it is only populated when importing a sweep.
+	if data.Method.IsNull() && data.ConfigYaml.IsNull() {
+		data.ConfigYaml = types.StringValue(sweep.Config)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SweepResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SweepResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, diags := sweepConfig(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation UpsertSweep(
+			$id: ID!,
+			$config: String!,
+			$description: String,
+			$state: String,
+		) {
+			upsertSweep(input: {
+				id: $id,
+				config: $config,
+				description: $description,
+				state: $state,
+			}) {
+				sweep {
+					id
+					name
+				}
+			}
+		}
+	`)
+	gqlReq.Var("config", config)
+	gqlReq.Var("description", data.Description.ValueStringPointer())
+
+	sweep, err := readSweepHelper(ctx, data.EntityName.ValueString(), data.ProjectName.ValueString(), data.SweepId.ValueString(), r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading sweep",
+			"Could not read sweep, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	gqlReq.Var("id", sweep.ID)
+
+	// Leave a pending launch sweep pending, since only its scheduler can start it.
+	desiredState := sweepStates[data.State.ValueString()]
+	if sweep.State == "PENDING" && desiredState == "RUNNING" {
+		desiredState = sweep.State
+	}
+	gqlReq.Var("state", desiredState)
+
+	var result struct {
+		UpsertSweep struct {
+			Sweep *Sweep `json:"sweep"`
+		} `json:"upsertSweep"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating sweep",
+			"Could not update sweep, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if result.UpsertSweep.Sweep == nil {
+		resp.Diagnostics.AddError(
+			"Failed to update sweep",
+			"The API did not confirm the update of the sweep.",
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SweepResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SweepResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sweep, err := readSweepHelper(ctx, data.EntityName.ValueString(), data.ProjectName.ValueString(), data.SweepId.ValueString(), r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading sweep",
+			"Could not read sweep, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Runs created by the sweep are kept, only the sweep itself is deleted.
+	gqlReq := graphql.NewRequest(`
+		mutation DeleteSweeps($ids: [ID!]!) {
+			deleteSweeps(input: {ids: $ids, deleteRuns: false}) {
+				affectedCount
+			}
+		}
+	`)
+	gqlReq.Var("ids", []string{sweep.ID})
+
+	var result struct {
+		DeleteSweeps struct {
+			AffectedCount int `json:"affectedCount"`
+		} `json:"deleteSweeps"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting sweep",
+			"Could not delete sweep, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if result.DeleteSweeps.AffectedCount == 0 {
+		resp.Diagnostics.AddError(
+			"Failed to delete sweep",
+			"The API did not confirm the deletion of the sweep.",
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a sweep resource")
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *SweepResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// sweepConfig returns the sweep config sent to the API, either the raw YAML or the typed attributes
+// encoded as JSON, which is a subset of YAML.
+func sweepConfig(data *SweepResourceModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !data.ConfigYaml.IsNull() {
+		return data.ConfigYaml.ValueString(), diags
+	}
+
+	config := map[string]interface{}{
+		"method": data.Method.ValueString(),
+	}
+
+	if data.Metric != nil {
+		goal := "minimize"
+		if !data.Metric.Goal.IsNull() {
+			goal = data.Metric.Goal.ValueString()
+		}
+		config["metric"] = map[string]interface{}{
+			"name": data.Metric.Name.ValueString(),
+			"goal": goal,
+		}
+	}
+
+	if data.Parameters != nil {
+		parameters := map[string]interface{}{}
+		for name, parameter := range data.Parameters {
+			p := map[string]interface{}{}
+			if !parameter.Value.IsNull() {
+				p["value"] = decodeSweepParameterValue(parameter.Value.ValueString())
+			}
+			if parameter.Values != nil {
+				values := make([]interface{}, 0, len(parameter.Values))
+				for _, value := range parameter.Values {
+					values = append(values, decodeSweepParameterValue(value.ValueString()))
+				}
+				p["values"] = values
+			}
+			if !parameter.Distribution.IsNull() {
+				p["distribution"] = parameter.Distribution.ValueString()
+			}
+			if !parameter.Min.IsNull() {
+				p["min"] = parameter.Min.ValueFloat64()
+			}
+			if !parameter.Max.IsNull() {
+				p["max"] = parameter.Max.ValueFloat64()
+			}
+			parameters[name] = p
+		}
+		config["parameters"] = parameters
+	}
+
+	if data.EarlyTerminate != nil {
+		earlyTerminate := map[string]interface{}{
+			"type": data.EarlyTerminate.Type.ValueString(),
+		}
+		for key, value := range map[string]types.Int64{
+			"min_iter": data.EarlyTerminate.MinIter,
+			"max_iter": data.EarlyTerminate.MaxIter,
+			"eta":      data.EarlyTerminate.Eta,
+			"s":        data.EarlyTerminate.S,
+		} {
+			if !value.IsNull() {
+				earlyTerminate[key] = value.ValueInt64()
+			}
+		}
+		config["early_terminate"] = earlyTerminate
+	}
+
+	if !data.Program.IsNull() {
+		config["program"] = data.Program.ValueString()
+	}
+	if !data.Job.IsNull() {
+		config["job"] = data.Job.ValueString()
+	}
+
+	configBytes, err := json.Marshal(config)
+	if err != nil {
+		diags.AddError("Error encoding sweep config", err.Error())
+		return "", diags
+	}
+	return string(configBytes), diags
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSweepResource(t *testing.T) {
+	resourceName := "wandb_sweep.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckSweepResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSweepResourceConfig("running"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "sweep_id"),
+					resource.TestCheckResourceAttr(resourceName, "method", "random"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.learning_rate.distribution", "log_uniform_values"),
+					resource.TestCheckResourceAttr(resourceName, "state", "running"),
+				),
+			},
+			{
+				Config: testAccSweepResourceConfig("paused"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "paused"),
+				),
+			},
+			{
+				Config: testAccSweepResourceConfig("finished"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "finished"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSweepResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "wandb_sweep" {
+			continue
+		}
+
+		_, err := readSweepHelper(context.Background(), rs.Primary.Attributes["entity_name"], rs.Primary.Attributes["project_name"], rs.Primary.Attributes["sweep_id"], newGraphQLClient())
+		if err == nil {
+			return fmt.Errorf("sweep still exists: %s", rs.Primary.ID)
+		}
+		if !errors.Is(err, errSweepNotFound) {
+			return fmt.Errorf("checking that sweep %s was destroyed: %w", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccSweepResourceConfig(state string) string {
+	return fmt.Sprintf(`
+resource "wandb_sweep" "test" {
+  entity_name  = "terraform-acceptance-test"
+  project_name = "terraform-sweeps"
+  method       = "random"
+  program      = "train.py"
+  state        = %q
+
+  metric = {
+    name = "val_loss"
+    goal = "minimize"
+  }
+
+  parameters = {
+    learning_rate = {
+      distribution = "log_uniform_values"
+      min          = 0.0001
+      max          = 0.1
+    }
+    optimizer = {
+      values = ["adam", "sgd"]
+    }
+    epochs = {
+      value = "10"
+    }
+  }
+
+  early_terminate = {
+    type     = "hyperband"
+    min_iter = 3
+  }
+}
+`, state)
+}
+
+func TestSweepResourceRead_NotFound(t *testing.T) {
+	client, _ := newIntrospectionTestServer(t, nil, `{"data": {"project": {"sweep": null}}}`)
+	r := &SweepResource{client: client}
+
+	resp := testRead(r, testResourceState(t, r, map[string]tftypes.Value{
+		"id":           tftypes.NewValue(tftypes.String, "terraform-acceptance-test:example-project:abc123"),
+		"sweep_id":     tftypes.NewValue(tftypes.String, "abc123"),
+		"entity_name":  tftypes.NewValue(tftypes.String, "terraform-acceptance-test"),
+		"project_name": tftypes.NewValue(tftypes.String, "example-project"),
+		"state":        tftypes.NewValue(tftypes.String, "running"),
+	}))
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
+
+func TestSweepStateRequiresReplaceWhenFinished(t *testing.T) {
+	for _, tc := range []struct {
+		state, plan string
+		replace     bool
+	}{
+		{state: "finished", plan: "running", replace: true},
+		{state: "finished", plan: "paused", replace: true},
+		{state: "finished", plan: "finished", replace: false},
+		{state: "running", plan: "paused", replace: false},
+		{state: "paused", plan: "finished", replace: false},
+	} {
+		t.Run(tc.state+"_to_"+tc.plan, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				Path:       path.Root("state"),
+				StateValue: types.StringValue(tc.state),
+				PlanValue:  types.StringValue(tc.plan),
+				State:      tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})},
+				Plan:       tfsdk.Plan{Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})},
+			}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+			requiresReplaceWhenFinished.PlanModifyString(context.Background(), req, resp)
+			assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			assert.Equal(t, tc.replace, resp.RequiresReplace)
+		})
+	}
+}
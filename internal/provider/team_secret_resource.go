@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/machinebox/graphql"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TeamSecretResource{}
+var _ resource.ResourceWithConfigure = &TeamSecretResource{}
+var _ resource.ResourceWithImportState = &TeamSecretResource{}
+var _ resource.ResourceWithModifyPlan = &TeamSecretResource{}
+
+func NewTeamSecretResource() resource.Resource {
+	return &TeamSecretResource{}
+}
+
+type TeamSecretResource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type TeamSecretResourceModel struct {
+	Id         types.String   `tfsdk:"id"`
+	Name       types.String   `tfsdk:"name"`
+	EntityName types.String   `tfsdk:"entity_name"`
+	Value      types.String   `tfsdk:"value"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *TeamSecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "wandb_team_secret"
+}
+
+func (r *TeamSecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Team secret resource used with W&B Launch. Secrets are stored by W&B and referenced by name, so credentials do not need to be embedded in a run queue's resource_config. The secret value is never read back from the API. See [here](https://github.com/wandb/terraform-provider-wandb/blob/main/examples/resources/team_secret/resource.tf) for an example",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the secret. This is a composite ID of the entity name and the secret name, separated by a ':'",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the secret. This is unique within the entity and is how the secret is referenced.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the team that this secret belongs to. Defaults to the provider's default_entity.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The value of the secret. The API never returns this value, so changes made outside of Terraform are not detected.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *TeamSecretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TeamSecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		return
+	}
+
+	r.client.applyProviderDefaults(ctx, req, resp, "entity_name")
+}
+
+func (r *TeamSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamSecretResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	success, err := insertTeamSecret(ctx, data.EntityName.ValueString(), data.Name.ValueString(), data.Value.ValueString(), r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating team secret",
+			"Could not create team secret, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !success {
+		resp.Diagnostics.AddError(
+			"Failed to create team secret",
+			"The API did not confirm the creation of the team secret.",
+		)
+		return
+	}
+
+	data.Id = types.StringValue(generateCompositeID(data.EntityName.ValueString(), data.Name.ValueString()))
+
+	tflog.Trace(ctx, "created a team secret resource")
+
+	resp.Diagnostics.Append(r.client.recordInstance(ctx, resp.Private)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamSecretResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkInstance(ctx, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	entityName, secretName, err := parseCompositeID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing composite ID", err.Error())
+		return
+	}
+
+	secret, err := readTeamSecretHelper(ctx, entityName, secretName, r.client)
+	if errors.Is(err, errSecretNotFound) {
+		tflog.Warn(ctx, "team secret no longer exists, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading team secret",
+			"Could not read team secret, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// The secret value is write-only, so only the identifying fields are refreshed.
+	data.Name = types.StringValue(secret.Name)
+	data.EntityName = types.StringValue(entityName)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TeamSecretResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	success, err := insertTeamSecret(ctx, data.EntityName.ValueString(), data.Name.ValueString(), data.Value.ValueString(), r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating team secret",
+			"Could not update team secret, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !success {
+		resp.Diagnostics.AddError(
+			"Failed to update team secret",
+			"The API did not confirm the update of the team secret.",
+		)
+		return
+	}
+
+	data.Id = types.StringValue(generateCompositeID(data.EntityName.ValueString(), data.Name.ValueString()))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamSecretResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entityName, secretName, err := parseCompositeID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing composite ID", err.Error())
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation DeleteSecret($entityName: String!, $secretName: String!) {
+			deleteSecret(input: {entityName: $entityName, secretName: $secretName}) {
+				success
+			}
+		}
+	`)
+	gqlReq.Var("entityName", entityName)
+	gqlReq.Var("secretName", secretName)
+
+	var result struct {
+		DeleteSecret struct {
+			Success bool `json:"success"`
+		} `json:"deleteSecret"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting team secret",
+			"Could not delete team secret, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !result.DeleteSecret.Success {
+		resp.Diagnostics.AddError(
+			"Failed to delete team secret",
+			"The API did not confirm the deletion of the team secret.",
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a team secret resource")
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *TeamSecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// insertTeamSecret creates the secret or overwrites the value of an existing secret with the same name.
+func insertTeamSecret(ctx context.Context, entityName, secretName, secretValue string, client *GraphQLClientWithHeaders) (bool, error) {
+	gqlReq := graphql.NewRequest(`
+		mutation InsertSecret($entityName: String!, $secretName: String!, $secretValue: String!) {
+			insertSecret(input: {entityName: $entityName, secretName: $secretName, secretValue: $secretValue}) {
+				success
+			}
+		}
+	`)
+	gqlReq.Var("entityName", entityName)
+	gqlReq.Var("secretName", secretName)
+	gqlReq.Var("secretValue", secretValue)
+
+	var result struct {
+		InsertSecret struct {
+			Success bool `json:"success"`
+		} `json:"insertSecret"`
+	}
+
+	if err := client.Run(ctx, gqlReq, &result); err != nil {
+		return false, err
+	}
+	return result.InsertSecret.Success, nil
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccTeamSecretResource(t *testing.T) {
+	resourceName := "wandb_team_secret.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckTeamSecretResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamSecretResourceConfig("first-value"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "terraform-acceptance-test:EXAMPLE_SECRET"),
+					resource.TestCheckResourceAttr(resourceName, "entity_name", "terraform-acceptance-test"),
+					resource.TestCheckResourceAttr(resourceName, "name", "EXAMPLE_SECRET"),
+					resource.TestCheckResourceAttr(resourceName, "value", "first-value"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value"},
+			},
+			{
+				Config: testAccTeamSecretResourceConfig("second-value"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", "second-value"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTeamSecretResource_DefaultEntity(t *testing.T) {
+	resourceName := "wandb_team_secret.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckTeamSecretResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamSecretResourceConfigDefaultEntity(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "terraform-acceptance-test:EXAMPLE_DEFAULT_ENTITY_SECRET"),
+					resource.TestCheckResourceAttr(resourceName, "entity_name", "terraform-acceptance-test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTeamSecretResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "wandb_team_secret" {
+			continue
+		}
+
+		client := newGraphQLClient()
+
+		_, err := readTeamSecretHelper(context.Background(), rs.Primary.Attributes["entity_name"], rs.Primary.Attributes["name"], client)
+		if err == nil {
+			return fmt.Errorf("team secret still exists: %s", rs.Primary.ID)
+		}
+		if !errors.Is(err, errSecretNotFound) {
+			return fmt.Errorf("checking that team secret %s was destroyed: %w", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccTeamSecretResourceConfig(value string) string {
+	return fmt.Sprintf(`
+resource "wandb_team_secret" "test" {
+  name        = "EXAMPLE_SECRET"
+  entity_name = "terraform-acceptance-test"
+  value       = %q
+}
+`, value)
+}
+
+func testAccTeamSecretResourceConfigDefaultEntity() string {
+	return `
+provider "wandb" {
+  default_entity = "terraform-acceptance-test"
+}
+
+resource "wandb_team_secret" "test" {
+  name  = "EXAMPLE_DEFAULT_ENTITY_SECRET"
+  value = "value"
+}
+`
+}
+
+func TestTeamSecretResourceRead_NotFound(t *testing.T) {
+	client, _ := newIntrospectionTestServer(t, nil, `{"data": {"entity": {"secrets": [{"name": "OTHER_SECRET"}]}}}`)
+	r := &TeamSecretResource{client: client}
+
+	resp := testRead(r, testResourceState(t, r, map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, "terraform-acceptance-test:EXAMPLE_SECRET"),
+		"entity_name": tftypes.NewValue(tftypes.String, "terraform-acceptance-test"),
+		"name":        tftypes.NewValue(tftypes.String, "EXAMPLE_SECRET"),
+		"value":       tftypes.NewValue(tftypes.String, "value"),
+	}))
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
+
+func TestTeamSecretResourceDelete_InvalidTimeout(t *testing.T) {
+	client, queries := newIntrospectionTestServer(t, nil, `{"data": {"deleteSecret": {"success": true}}}`)
+	r := &TeamSecretResource{client: client}
+
+	timeoutsType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"create": tftypes.String,
+		"read":   tftypes.String,
+		"update": tftypes.String,
+		"delete": tftypes.String,
+	}}
+	resp := testDelete(r, testResourceState(t, r, map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, "terraform-acceptance-test:EXAMPLE_SECRET"),
+		"entity_name": tftypes.NewValue(tftypes.String, "terraform-acceptance-test"),
+		"name":        tftypes.NewValue(tftypes.String, "EXAMPLE_SECRET"),
+		"value":       tftypes.NewValue(tftypes.String, "value"),
+		"timeouts": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+			"create": tftypes.NewValue(tftypes.String, nil),
+			"read":   tftypes.NewValue(tftypes.String, nil),
+			"update": tftypes.NewValue(tftypes.String, nil),
+			"delete": tftypes.NewValue(tftypes.String, "ten minutes"),
+		}),
+	}))
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Empty(t, *queries, "the secret was deleted despite the invalid timeout")
+}
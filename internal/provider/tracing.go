@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation scope of the spans created for API requests.
+const tracerName = "github.com/wandb/terraform-provider-wandb"
+
+// entityAttributeKey is the span attribute holding the entity a GraphQL operation acts on.
+const entityAttributeKey = "wandb.entity"
+
+// tracingEnabled reports whether OTLP tracing is configured through the standard OpenTelemetry
+// environment variables: an OTLP endpoint must be set, and neither OTEL_SDK_DISABLED nor
+// OTEL_TRACES_EXPORTER may turn tracing off.
+func tracingEnabled() bool {
+	if strings.EqualFold(os.Getenv("OTEL_SDK_DISABLED"), "true") {
+		return false
+	}
+	if exporter := os.Getenv("OTEL_TRACES_EXPORTER"); exporter != "" && exporter != "otlp" {
+		return false
+	}
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// tracerProviders holds the tracer providers created by newTracerProvider, until ShutdownTracing
+// exports their remaining spans.
+var tracerProviders struct {
+	mu        sync.Mutex
+	providers []*sdktrace.TracerProvider
+}
+
+// newTracerProvider creates a tracer provider that exports spans over OTLP/HTTP, configured by the
+// standard OpenTelemetry environment variables, or returns nil if tracing is not enabled. Spans are
+// exported in batches in the background, so an unreachable collector does not slow down API requests.
+// ShutdownTracing must be called before the provider process exits to export the last batch.
+func newTracerProvider(ctx context.Context) (*sdktrace.TracerProvider, error) {
+	if !tracingEnabled() {
+		return nil, nil
+	}
+
+	for _, name := range []string{"OTEL_EXPORTER_OTLP_TRACES_PROTOCOL", "OTEL_EXPORTER_OTLP_PROTOCOL"} {
+		if protocol := os.Getenv(name); protocol != "" && protocol != "http/protobuf" {
+			return nil, fmt.Errorf("unsupported OTLP protocol %q in %s, only http/protobuf is supported", protocol, name)
+		}
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Attributes from OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES override the defaults.
+	res, err := sdkresource.New(ctx,
+		sdkresource.WithAttributes(semconv.ServiceName("terraform-provider-wandb")),
+		sdkresource.WithTelemetrySDK(),
+		sdkresource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+
+	tracerProviders.mu.Lock()
+	tracerProviders.providers = append(tracerProviders.providers, tracerProvider)
+	tracerProviders.mu.Unlock()
+	return tracerProvider, nil
+}
+
+// ShutdownTracing exports the spans that have not been exported yet and shuts down the tracer
+// providers created when configuring the provider. It is called when the provider process ends.
+func ShutdownTracing(ctx context.Context) error {
+	tracerProviders.mu.Lock()
+	providers := tracerProviders.providers
+	tracerProviders.providers = nil
+	tracerProviders.mu.Unlock()
+
+	var errs []error
+	for _, tracerProvider := range providers {
+		if err := tracerProvider.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// defaultTracer returns the tracer of the global tracer provider, which does not record spans unless
+// an application sets it.
+func defaultTracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// annotateGraphQLSpan names the span of the request in ctx after the GraphQL operation and records the
+// operation and the entity it acts on.
+func annotateGraphQLSpan(ctx context.Context, operationType, operationName string, variables map[string]interface{}) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+
+	span.SetName(strings.TrimSpace(operationType + " " + operationName))
+	span.SetAttributes(
+		semconv.GraphqlOperationTypeKey.String(operationType),
+		semconv.GraphqlOperationName(operationName),
+	)
+	if entityName, ok := variables["entityName"].(string); ok && entityName != "" {
+		span.SetAttributes(attribute.String(entityAttributeKey, entityName))
+	}
+}
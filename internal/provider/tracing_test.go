@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/machinebox/graphql"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTracingTestServer(t *testing.T, response string, traceparents *[]string) *GraphQLClientWithHeaders {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*traceparents = append(*traceparents, r.Header.Get("traceparent"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+	return NewGraphQLClientWithHeaders(server.URL+"/graphql", http.Header{}, nil)
+}
+
+func newUpsertRunQueueRequest() *graphql.Request {
+	gqlReq := graphql.NewRequest(`
+		mutation UpsertRunQueue($entityName: String!, $queueName: String!) {
+			upsertRunQueue(input: {entityName: $entityName, queueName: $queueName}) {
+				success
+			}
+		}
+	`)
+	gqlReq.Var("entityName", "example-entity")
+	gqlReq.Var("queueName", "example-queue")
+	return gqlReq
+}
+
+func TestRunTracing(t *testing.T) {
+	var traceparents []string
+	client := newTracingTestServer(t, `{"data": {"upsertRunQueue": {"success": true}}}`, &traceparents)
+
+	recorder := tracetest.NewSpanRecorder()
+	client.tracer = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer(tracerName)
+
+	assert.NoError(t, client.Run(context.Background(), newUpsertRunQueueRequest(), nil))
+
+	spans := recorder.Ended()
+	if !assert.Len(t, spans, 1) {
+		return
+	}
+	span := spans[0]
+	assert.Equal(t, "mutation UpsertRunQueue", span.Name())
+	assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+	assert.Equal(t, codes.Ok, span.Status().Code)
+	assert.Subset(t, span.Attributes(), []attribute.KeyValue{
+		attribute.String("graphql.operation.type", "mutation"),
+		attribute.String("graphql.operation.name", "UpsertRunQueue"),
+		attribute.String(entityAttributeKey, "example-entity"),
+		attribute.Int("http.response.status_code", http.StatusOK),
+	})
+
+	if assert.Len(t, traceparents, 1) {
+		assert.Contains(t, traceparents[0], span.SpanContext().TraceID().String())
+	}
+}
+
+func TestRunTracing_Error(t *testing.T) {
+	var traceparents []string
+	client := newTracingTestServer(t, `{"errors": [{"message": "entity not found"}]}`, &traceparents)
+
+	recorder := tracetest.NewSpanRecorder()
+	client.tracer = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer(tracerName)
+
+	assert.Error(t, client.Run(context.Background(), newUpsertRunQueueRequest(), nil))
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 1) {
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+		assert.Equal(t, "graphql: entity not found", spans[0].Status().Description)
+	}
+}
+
+func TestRunTracing_Disabled(t *testing.T) {
+	var traceparents []string
+	client := newTracingTestServer(t, `{"data": {"upsertRunQueue": {"success": true}}}`, &traceparents)
+
+	assert.NoError(t, client.Run(context.Background(), newUpsertRunQueueRequest(), nil))
+	assert.Equal(t, []string{""}, traceparents)
+}
+
+func TestNewTracerProvider_Disabled(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+
+	tracerProvider, err := newTracerProvider(context.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, tracerProvider)
+
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://localhost:4318")
+	t.Setenv("OTEL_SDK_DISABLED", "true")
+
+	tracerProvider, err = newTracerProvider(context.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, tracerProvider)
+}
+
+func TestNewTracerProvider_UnsupportedProtocol(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://localhost:4317")
+	t.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", "grpc")
+
+	_, err := newTracerProvider(context.Background())
+	assert.Error(t, err)
+}
+
+func TestNewTracerProvider_LocalCollector(t *testing.T) {
+	var mu sync.Mutex
+	var exported []*collectortrace.ExportTraceServiceRequest
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("reading export request: %v", err)
+		}
+		request := &collectortrace.ExportTraceServiceRequest{}
+		if err := proto.Unmarshal(body, request); err != nil {
+			t.Errorf("decoding export request: %v", err)
+		}
+		mu.Lock()
+		exported = append(exported, request)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/x-protobuf")
+	}))
+	t.Cleanup(collector.Close)
+
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", collector.URL)
+	t.Setenv("OTEL_SERVICE_NAME", "wandb-acceptance-test")
+
+	ctx := context.Background()
+	tracerProvider, err := newTracerProvider(ctx)
+	if !assert.NoError(t, err) || !assert.NotNil(t, tracerProvider) {
+		return
+	}
+
+	var traceparents []string
+	client := newTracingTestServer(t, `{"data": {"upsertRunQueue": {"success": true}}}`, &traceparents)
+	client.tracer = tracerProvider.Tracer(tracerName)
+	assert.NoError(t, client.Run(ctx, newUpsertRunQueueRequest(), nil))
+
+	// Spans are batched, so the request does not wait for the export.
+	mu.Lock()
+	assert.Empty(t, exported)
+	mu.Unlock()
+	assert.NoError(t, ShutdownTracing(ctx))
+
+	mu.Lock()
+	defer mu.Unlock()
+	if !assert.Len(t, exported, 1) {
+		return
+	}
+	resourceSpans := exported[0].GetResourceSpans()
+	if !assert.Len(t, resourceSpans, 1) {
+		return
+	}
+	serviceName := ""
+	for _, resourceAttribute := range resourceSpans[0].GetResource().GetAttributes() {
+		if resourceAttribute.GetKey() == "service.name" {
+			serviceName = resourceAttribute.GetValue().GetStringValue()
+		}
+	}
+	assert.Equal(t, "wandb-acceptance-test", serviceName)
+	scopeSpans := resourceSpans[0].GetScopeSpans()
+	if assert.Len(t, scopeSpans, 1) && assert.Len(t, scopeSpans[0].GetSpans(), 1) {
+		assert.Equal(t, tracerName, scopeSpans[0].GetScope().GetName())
+		assert.Equal(t, "mutation UpsertRunQueue", scopeSpans[0].GetSpans()[0].GetName())
+	}
+}
@@ -1,14 +1,18 @@
 package provider
 
 type RunQueue struct {
-	ID                    string        `json:"id"`
-	Name                  string        `json:"name"`
-	EntityName            string        `json:"entityName"`
-	PrioritizationMode    string        `json:"prioritizationMode"`
-	ExternalLinks         ExternalLinks `json:"externalLinks"`
-	CreatedAt             string        `json:"createdAt"`
-	UpdatedAt             string        `json:"updatedAt"`
-	DefaultResourceConfig struct {
+	ID                       string          `json:"id"`
+	Name                     string          `json:"name"`
+	EntityName               string          `json:"entityName"`
+	PrioritizationMode       string          `json:"prioritizationMode"`
+	DefaultPriority          *int            `json:"defaultPriority"`
+	MaxConcurrentRuns        *int64          `json:"maxConcurrentRuns"`
+	MaxConcurrentRunsPerUser *int64          `json:"maxConcurrentRunsPerUser"`
+	Access                   *RunQueueAccess `json:"access"`
+	ExternalLinks            ExternalLinks   `json:"externalLinks"`
+	CreatedAt                string          `json:"createdAt"`
+	UpdatedAt                string          `json:"updatedAt"`
+	DefaultResourceConfig    struct {
 		ID                string                 `json:"id"`
 		Resource          string                 `json:"resource"`
 		Config            map[string]interface{} `json:"config"`
@@ -16,6 +20,15 @@ type RunQueue struct {
 	} `json:"defaultResourceConfig"`
 }
 
+// RunQueueAccess restricts who can push to a run queue. Empty lists leave the queue open to every
+// member of its entity.
+type RunQueueAccess struct {
+	Users           []string `json:"users"`
+	Teams           []string `json:"teams"`
+	ServiceAccounts []string `json:"serviceAccounts"`
+	AdminOnlyConfig bool     `json:"adminOnlyConfig"`
+}
+
 type ExternalLink struct {
 	Label string `json:"label"`
 	URL   string `json:"url"`
@@ -45,14 +58,18 @@ type TemplateVariable struct {
 }
 
 type UpsertRunQueueInput struct {
-	QueueName          string  `json:"queueName"`
-	EntityName         string  `json:"entityName"`
-	ProjectName        string  `json:"projectName"`
-	ResourceType       string  `json:"resourceType"`
-	ResourceConfig     string  `json:"resourceConfig"`
-	TemplateVariables  *string `json:"templateVariables"`
-	PrioritizationMode *string `json:"prioritizationMode"`
-	ExternalLinks      *string `json:"externalLinks"`
+	QueueName                string          `json:"queueName"`
+	EntityName               string          `json:"entityName"`
+	ProjectName              string          `json:"projectName"`
+	ResourceType             string          `json:"resourceType"`
+	ResourceConfig           string          `json:"resourceConfig"`
+	TemplateVariables        *string         `json:"templateVariables"`
+	PrioritizationMode       *string         `json:"prioritizationMode"`
+	DefaultPriority          *int            `json:"defaultPriority"`
+	MaxConcurrentRuns        *int64          `json:"maxConcurrentRuns"`
+	MaxConcurrentRunsPerUser *int64          `json:"maxConcurrentRunsPerUser"`
+	Access                   *RunQueueAccess `json:"access"`
+	ExternalLinks            *string         `json:"externalLinks"`
 }
 
 type UpsertRunQueueResponse struct {
@@ -61,3 +78,276 @@ type UpsertRunQueueResponse struct {
 		Errors  []string `json:"configSchemaValidationErrors"`
 	} `json:"upsertRunQueue"`
 }
+
+type TeamNode struct {
+	Name string `json:"name"`
+}
+
+type TeamEdge struct {
+	Node TeamNode `json:"node"`
+}
+
+type TeamConnection struct {
+	Edges []TeamEdge `json:"edges"`
+}
+
+type User struct {
+	ID        string         `json:"id"`
+	Username  string         `json:"username"`
+	Email     string         `json:"email"`
+	Admin     bool           `json:"admin"`
+	DeletedAt *string        `json:"deletedAt"`
+	Teams     TeamConnection `json:"teams"`
+}
+
+type Secret struct {
+	EntityID  int    `json:"entityId"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type ArtifactAlias struct {
+	Alias                  string `json:"alias"`
+	ArtifactCollectionName string `json:"artifactCollectionName"`
+}
+
+type ArtifactTag struct {
+	Name string `json:"name"`
+}
+
+type Artifact struct {
+	ID           string          `json:"id"`
+	Digest       string          `json:"digest"`
+	VersionIndex *int            `json:"versionIndex"`
+	Description  *string         `json:"description"`
+	Metadata     *string         `json:"metadata"`
+	State        string          `json:"state"`
+	Aliases      []ArtifactAlias `json:"aliases"`
+	Tags         []ArtifactTag   `json:"tags"`
+	ArtifactType struct {
+		Name string `json:"name"`
+	} `json:"artifactType"`
+}
+
+// ArtifactPath identifies an artifact version as entity/project/name:version.
+type ArtifactPath struct {
+	EntityName  string
+	ProjectName string
+	Name        string
+	Version     string
+}
+
+type ArtifactTypeConnection struct {
+	Edges []struct {
+		Node struct {
+			Name string `json:"name"`
+		} `json:"node"`
+	} `json:"edges"`
+}
+
+type ProjectMember struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	Role     struct {
+		Name string `json:"name"`
+	} `json:"role"`
+}
+
+type Registry struct {
+	ID                              string                 `json:"id"`
+	Name                            string                 `json:"name"`
+	EntityName                      string                 `json:"entityName"`
+	Description                     *string                `json:"description"`
+	Access                          string                 `json:"access"`
+	AllowAllArtifactTypesInRegistry bool                   `json:"allowAllArtifactTypesInRegistry"`
+	ArtifactTypes                   ArtifactTypeConnection `json:"artifactTypes"`
+	Members                         []ProjectMember        `json:"members"`
+}
+
+type ArtifactCollectionTagConnection struct {
+	Edges []struct {
+		Node ArtifactTag `json:"node"`
+	} `json:"edges"`
+}
+
+type ArtifactCollection struct {
+	ID                  string                          `json:"id"`
+	Name                string                          `json:"name"`
+	Description         *string                         `json:"description"`
+	Tags                ArtifactCollectionTagConnection `json:"tags"`
+	DefaultArtifactType struct {
+		Name string `json:"name"`
+	} `json:"defaultArtifactType"`
+}
+
+type Trigger struct {
+	ID                  string              `json:"id"`
+	Name                string              `json:"name"`
+	Description         *string             `json:"description"`
+	Enabled             bool                `json:"enabled"`
+	Scope               TriggerScope        `json:"scope"`
+	TriggeringCondition TriggeringCondition `json:"triggeringCondition"`
+	TriggeredAction     TriggeredAction     `json:"triggeredAction"`
+}
+
+// TriggerScope is the project an automation watches. Registries are backed by projects.
+type TriggerScope struct {
+	Typename string `json:"__typename"`
+	Name     string `json:"name"`
+	Entity   struct {
+		Name string `json:"name"`
+	} `json:"entity"`
+}
+
+// TriggeringCondition is the event an automation is triggered by, with its JSON event filter.
+type TriggeringCondition struct {
+	EventType string `json:"eventType"`
+	Filter    string `json:"filter"`
+}
+
+// TriggeredAction is a union of the actions an automation can run, discriminated by Typename.
+type TriggeredAction struct {
+	Typename string `json:"__typename"`
+	Queue    *struct {
+		Name       string `json:"name"`
+		EntityName string `json:"entityName"`
+	} `json:"queue"`
+	Template       *string             `json:"template"`
+	Integration    *TriggerIntegration `json:"integration"`
+	RequestPayload *string             `json:"requestPayload"`
+	Title          *string             `json:"title"`
+	Message        *string             `json:"message"`
+	Severity       *string             `json:"severity"`
+}
+
+// TriggerIntegration is the webhook or Slack integration an automation action sends to.
+type TriggerIntegration struct {
+	ID string `json:"id"`
+}
+
+type AutomationMetricFilter struct {
+	Name       string  `json:"name"`
+	WindowSize int64   `json:"window_size"`
+	AggOp      string  `json:"agg_op"`
+	CmpOp      string  `json:"cmp_op"`
+	Threshold  float64 `json:"threshold"`
+}
+
+// Integration is a union of the integration types an entity can configure, discriminated by Typename.
+type Integration struct {
+	Typename       string  `json:"__typename"`
+	ID             string  `json:"id"`
+	Name           *string `json:"name"`
+	URLEndpoint    *string `json:"urlEndpoint"`
+	SecretRef      *string `json:"secretRef"`
+	AccessTokenRef *string `json:"accessTokenRef"`
+	TeamName       *string `json:"teamName"`
+	ChannelName    *string `json:"channelName"`
+	CreatedAt      string  `json:"createdAt"`
+}
+
+type Sweep struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	State       string  `json:"state"`
+	Description *string `json:"description"`
+	Config      string  `json:"config"`
+}
+
+type RunQueueItem struct {
+	ID              string  `json:"id"`
+	State           string  `json:"state"`
+	AssociatedRunID *string `json:"associatedRunId"`
+	CreatedAt       string  `json:"createdAt"`
+}
+
+type StorageBucketInfo struct {
+	Provider string  `json:"provider"`
+	Name     string  `json:"name"`
+	Path     *string `json:"path"`
+	KmsKeyID *string `json:"kmsKeyId"`
+	Region   *string `json:"region"`
+}
+
+type StorageBucketCheck struct {
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+type OrganizationSettings struct {
+	DefaultTeamPrivacy  string   `json:"defaultTeamPrivacy"`
+	AllowedEmailDomains []string `json:"allowedEmailDomains"`
+	CodeSavingEnabled   bool     `json:"codeSavingEnabled"`
+	HidePublicProjects  bool     `json:"hidePublicProjects"`
+}
+
+type Organization struct {
+	ID       string               `json:"id"`
+	Name     string               `json:"name"`
+	Settings OrganizationSettings `json:"settings"`
+}
+
+type RolePermission struct {
+	Name        string  `json:"name"`
+	DisplayName *string `json:"displayName"`
+	Description *string `json:"description"`
+	Group       *string `json:"group"`
+}
+
+type CustomRole struct {
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	Description   *string `json:"description"`
+	InheritedFrom *struct {
+		Name string `json:"name"`
+	} `json:"inheritedFrom"`
+	Permissions []RolePermission `json:"permissions"`
+}
+
+const (
+	scimUserSchema    = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimGroupSchema   = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimPatchOpSchema = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+)
+
+type SCIMEmail struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary"`
+}
+
+type SCIMUser struct {
+	Schemas     []string    `json:"schemas,omitempty"`
+	ID          string      `json:"id,omitempty"`
+	UserName    string      `json:"userName"`
+	DisplayName *string     `json:"displayName,omitempty"`
+	Emails      []SCIMEmail `json:"emails"`
+	Active      *bool       `json:"active,omitempty"`
+}
+
+type SCIMGroupMember struct {
+	Value string `json:"value"`
+}
+
+type SCIMGroup struct {
+	Schemas     []string          `json:"schemas,omitempty"`
+	ID          string            `json:"id,omitempty"`
+	DisplayName string            `json:"displayName"`
+	Members     []SCIMGroupMember `json:"members"`
+}
+
+type SCIMPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+type SCIMPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []SCIMPatchOperation `json:"Operations"`
+}
+
+type ServerInfo struct {
+	LatestLocalVersionInfo *struct {
+		VersionOnThisInstanceString string `json:"versionOnThisInstanceString"`
+	} `json:"latestLocalVersionInfo"`
+}
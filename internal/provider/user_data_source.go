@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UserDataSource{}
+var _ datasource.DataSourceWithConfigure = &UserDataSource{}
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+type UserDataSource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type UserDataSourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	Username types.String `tfsdk:"username"`
+	Email    types.String `tfsdk:"email"`
+	Admin    types.Bool   `tfsdk:"admin"`
+	Teams    types.List   `tfsdk:"teams"`
+}
+
+func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "wandb_user"
+}
+
+func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a W&B user by email or username.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the user.",
+			},
+			"username": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The username of the user. Exactly one of username or email must be specified.",
+			},
+			"email": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The email address of the user. Exactly one of username or email must be specified.",
+			},
+			"admin": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the user is an instance admin.",
+			},
+			"teams": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The names of the teams the user is a member of.",
+			},
+		},
+	}
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Email.IsNull() == data.Username.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid user lookup",
+			"Exactly one of email or username must be specified.",
+		)
+		return
+	}
+
+	user, err := findUserHelper(ctx, data.Email.ValueString(), data.Username.ValueString(), d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading user",
+			"Could not read user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	teams, teamsDiags := teamConnectionToList(user.Teams)
+	resp.Diagnostics.Append(teamsDiags...)
+
+	data.Id = types.StringValue(user.ID)
+	data.Username = types.StringValue(user.Username)
+	data.Email = types.StringValue(user.Email)
+	data.Admin = types.BoolValue(user.Admin)
+	data.Teams = teams
+
+	tflog.Trace(ctx, "read a user data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
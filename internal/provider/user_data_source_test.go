@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserDataSource(t *testing.T) {
+	dataSourceName := "data.wandb_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "wandb_user.test", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "username", "wandb_user.test", "username"),
+					resource.TestCheckResourceAttr(dataSourceName, "email", "terraform-acceptance-lookup@example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "admin", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserDataSourceConfig() string {
+	return `
+resource "wandb_user" "test" {
+  email = "terraform-acceptance-lookup@example.com"
+}
+
+data "wandb_user" "test" {
+  email = wandb_user.test.email
+}
+`
+}
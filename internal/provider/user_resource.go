@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/machinebox/graphql"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithConfigure = &UserResource{}
+var _ resource.ResourceWithImportState = &UserResource{}
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+type UserResource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type UserResourceModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Email    types.String   `tfsdk:"email"`
+	Admin    types.Bool     `tfsdk:"admin"`
+	Username types.String   `tfsdk:"username"`
+	Teams    types.List     `tfsdk:"teams"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "wandb_user"
+}
+
+func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "User resource for W&B Server deployments. Creating a user requires instance admin privileges, and destroying the resource deactivates the user. See [here](https://github.com/wandb/terraform-provider-wandb/blob/main/examples/resources/user/resource.tf) for an example",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Required:    true,
+				Description: "The email address of the user. Changing this forces a new user to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"admin": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the user is an instance admin. Defaults to false, so omitting it from the configuration of an admin plans to remove the admin role.",
+			},
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "The username assigned to the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"teams": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The names of the teams the user is a member of.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation CreateUserFromAdmin($email: String!, $admin: Boolean) {
+			createUser(input: {email: $email, admin: $admin}) {
+				user {` + userFields + `}
+			}
+		}
+	`)
+	gqlReq.Var("email", data.Email.ValueString())
+	gqlReq.Var("admin", data.Admin.ValueBool())
+
+	var result struct {
+		CreateUser struct {
+			User *User `json:"user"`
+		} `json:"createUser"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating user",
+			"Could not create user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if result.CreateUser.User == nil {
+		resp.Diagnostics.AddError(
+			"Failed to create user",
+			"The API did not return the created user.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setUserResourceModel(&data, result.CreateUser.User)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created a user resource")
+
+	resp.Diagnostics.Append(r.client.recordInstance(ctx, resp.Private)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkInstance(ctx, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := readUserHelper(ctx, data.Id.ValueString(), r.client)
+	if errors.Is(err, errUserNotFound) {
+		tflog.Warn(ctx, "user no longer exists, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading user",
+			"Could not read user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setUserResourceModel(&data, user)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation UpdateUser($id: ID!, $admin: Boolean) {
+			updateUser(input: {id: $id, admin: $admin}) {
+				user {` + userFields + `}
+			}
+		}
+	`)
+	gqlReq.Var("id", data.Id.ValueString())
+	gqlReq.Var("admin", data.Admin.ValueBool())
+
+	var result struct {
+		UpdateUser struct {
+			User *User `json:"user"`
+		} `json:"updateUser"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating user",
+			"Could not update user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if result.UpdateUser.User == nil {
+		resp.Diagnostics.AddError(
+			"Failed to update user",
+			"The API did not return the updated user.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setUserResourceModel(&data, result.UpdateUser.User)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation DeleteUser($id: ID!) {
+			deleteUser(input: {id: $id}) {
+				user {
+					id
+					deletedAt
+				}
+			}
+		}
+	`)
+	gqlReq.Var("id", data.Id.ValueString())
+
+	var result struct {
+		DeleteUser struct {
+			User *User `json:"user"`
+		} `json:"deleteUser"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deactivating user",
+			"Could not deactivate user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if result.DeleteUser.User == nil || result.DeleteUser.User.DeletedAt == nil {
+		resp.Diagnostics.AddError(
+			"Failed to deactivate user",
+			"The API did not confirm the deactivation of the user.",
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deactivated a user resource")
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func setUserResourceModel(data *UserResourceModel, user *User) diag.Diagnostics {
+	teams, diags := teamConnectionToList(user.Teams)
+
+	data.Id = types.StringValue(user.ID)
+	data.Email = types.StringValue(user.Email)
+	data.Username = types.StringValue(user.Username)
+	data.Teams = teams
+	data.Admin = types.BoolValue(user.Admin)
+
+	return diags
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccUserResource(t *testing.T) {
+	resourceName := "wandb_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckUserResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "username"),
+					resource.TestCheckResourceAttr(resourceName, "email", "terraform-acceptance-user@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "admin", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUserResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "email", "terraform-acceptance-user@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "admin", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUserResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "wandb_user" {
+			continue
+		}
+
+		client := newGraphQLClient()
+
+		_, err := readUserHelper(context.Background(), rs.Primary.ID, client)
+		if err == nil {
+			return fmt.Errorf("user still active: %s", rs.Primary.ID)
+		}
+		if !errors.Is(err, errUserNotFound) {
+			return fmt.Errorf("checking that user %s was destroyed: %w", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccUserResourceConfig(admin bool) string {
+	return fmt.Sprintf(`
+resource "wandb_user" "test" {
+  email = "terraform-acceptance-user@example.com"
+  admin = %t
+}
+`, admin)
+}
+
+func TestUserResourceRead_NotFound(t *testing.T) {
+	client, _ := newIntrospectionTestServer(t, nil, `{"data": {"user": null}}`)
+	r := &UserResource{client: client}
+
+	resp := testRead(r, testResourceState(t, r, map[string]tftypes.Value{
+		"id":    tftypes.NewValue(tftypes.String, "missing-user"),
+		"email": tftypes.NewValue(tftypes.String, "terraform-example@example.com"),
+		"admin": tftypes.NewValue(tftypes.Bool, true),
+	}))
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
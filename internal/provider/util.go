@@ -3,13 +3,21 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/machinebox/graphql"
+	"gopkg.in/yaml.v3"
 )
 
 func convertExternalLinksMapToInputType(externalLinksMap map[string]attr.Value) (*string, error) {
@@ -68,12 +76,34 @@ func parseCompositeID(compositeID string) (string, string, error) {
 	return parts[0], parts[1], nil
 }
 
+// runQueueOptionalSelections are the selections of RunQueue fields that older W&B servers do not
+// support, in the order they are queried.
+var runQueueOptionalSelections = []struct {
+	field     string
+	selection string
+}{
+	{"prioritizationMode", "prioritizationMode"},
+	{"defaultPriority", "defaultPriority"},
+	{"maxConcurrentRuns", "maxConcurrentRuns"},
+	{"maxConcurrentRunsPerUser", "maxConcurrentRunsPerUser"},
+	{"access", "access { users teams serviceAccounts adminOnlyConfig }"},
+	{"externalLinks", "externalLinks"},
+}
+
 func readRunQueueHelper(entityName, queueName string, ctx context.Context, client GraphQLClientWithHeaders) (*RunQueue, error) {
 	if entityName == "" || queueName == "" {
 		return nil, fmt.Errorf("entity_name and name and name must be specified")
 	}
 
-	gqlReq := graphql.NewRequest(`
+	// Fields the server does not support are left out of the query, and keep their zero values.
+	var optionalSelections []string
+	for _, optional := range runQueueOptionalSelections {
+		if client.supportsField(ctx, "RunQueue", optional.field) {
+			optionalSelections = append(optionalSelections, optional.selection)
+		}
+	}
+
+	gqlReq := graphql.NewRequest(fmt.Sprintf(`
 		query GetRunQueueByName($entityName:String!, $projectName: String!, $queueName: String!) {
 			project(entityName: $entityName, name: $projectName) {
 				runQueue(name: $queueName) {
@@ -90,14 +120,13 @@ func readRunQueueHelper(entityName, queueName string, ctx context.Context, clien
 							schema
 						}
 					}
-					prioritizationMode
-					externalLinks
+					%s
 					createdAt
 					updatedAt
 				}
 			}
 		}
-	`)
+	`, strings.Join(optionalSelections, "\n")))
 	gqlReq.Var("entityName", entityName)
 	gqlReq.Var("queueName", queueName)
 	gqlReq.Var("projectName", "model-registry")
@@ -185,12 +214,17 @@ func stripResourceArgsAndResourceFields(resourceConfig, resourceType string) (st
 }
 
 func normalizeTemplateVariables(templateVariables *string) (*string, error) {
-	if templateVariables == nil {
+	return normalizeJSONObject(templateVariables)
+}
+
+// normalizeJSONObject re-encodes a JSON object so that semantically equal values compare equal in state.
+func normalizeJSONObject(value *string) (*string, error) {
+	if value == nil {
 		return nil, nil
 	}
 
 	var normalized map[string]interface{}
-	if err := json.Unmarshal([]byte(*templateVariables), &normalized); err != nil {
+	if err := json.Unmarshal([]byte(*value), &normalized); err != nil {
 		return nil, err
 	}
 	normalizedBytes, err := json.Marshal(normalized)
@@ -200,3 +234,1389 @@ func normalizeTemplateVariables(templateVariables *string) (*string, error) {
 	normalizedString := string(normalizedBytes)
 	return &normalizedString, nil
 }
+
+const userFields = `
+	id
+	username
+	email
+	admin
+	deletedAt
+	teams {
+		edges {
+			node {
+				name
+			}
+		}
+	}
+`
+
+// errUserNotFound is returned when a user does not exist or has been deleted.
+var errUserNotFound = errors.New("user not found")
+
+func readUserHelper(ctx context.Context, id string, client *GraphQLClientWithHeaders) (*User, error) {
+	if id == "" {
+		return nil, fmt.Errorf("user id must be specified")
+	}
+
+	gqlReq := graphql.NewRequest(`
+		query GetUser($id: ID!) {
+			user(id: $id) {` + userFields + `}
+		}
+	`)
+	gqlReq.Var("id", id)
+
+	var result struct {
+		User *User `json:"user,omitempty"`
+	}
+
+	if err := client.Run(ctx, gqlReq, &result); err != nil {
+		return nil, err
+	}
+
+	if result.User == nil || result.User.DeletedAt != nil {
+		return nil, errUserNotFound
+	}
+
+	return result.User, nil
+}
+
+// findUserHelper looks up an active user by exact email or username match.
+func findUserHelper(ctx context.Context, email, username string, client *GraphQLClientWithHeaders) (*User, error) {
+	query := email
+	if query == "" {
+		query = username
+	}
+	if query == "" {
+		return nil, fmt.Errorf("email or username must be specified")
+	}
+
+	gqlReq := graphql.NewRequest(`
+		query SearchUsers($query: String) {
+			users(query: $query) {
+				edges {
+					node {` + userFields + `}
+				}
+			}
+		}
+	`)
+	gqlReq.Var("query", query)
+
+	var result struct {
+		Users struct {
+			Edges []struct {
+				Node User `json:"node"`
+			} `json:"edges"`
+		} `json:"users"`
+	}
+
+	if err := client.Run(ctx, gqlReq, &result); err != nil {
+		return nil, err
+	}
+
+	for _, edge := range result.Users.Edges {
+		user := edge.Node
+		if user.DeletedAt != nil {
+			continue
+		}
+		if (email != "" && strings.EqualFold(user.Email, email)) || (email == "" && user.Username == username) {
+			return &user, nil
+		}
+	}
+
+	return nil, errUserNotFound
+}
+
+func teamConnectionToList(teams TeamConnection) (types.List, diag.Diagnostics) {
+	result := make([]attr.Value, 0, len(teams.Edges))
+	for _, edge := range teams.Edges {
+		result = append(result, types.StringValue(edge.Node.Name))
+	}
+	return types.ListValue(types.StringType, result)
+}
+
+// errSecretNotFound is returned when an entity has no secret with the requested name.
+var errSecretNotFound = errors.New("secret not found")
+
+func readTeamSecretHelper(ctx context.Context, entityName, secretName string, client *GraphQLClientWithHeaders) (*Secret, error) {
+	if entityName == "" || secretName == "" {
+		return nil, fmt.Errorf("entity_name and name must be specified")
+	}
+
+	gqlReq := graphql.NewRequest(`
+		query GetSecrets($entityName: String!) {
+			entity(name: $entityName) {
+				secrets {
+					entityId
+					name
+					createdAt
+				}
+			}
+		}
+	`)
+	gqlReq.Var("entityName", entityName)
+
+	var result struct {
+		Entity *struct {
+			Secrets []Secret `json:"secrets"`
+		} `json:"entity"`
+	}
+
+	if err := client.Run(ctx, gqlReq, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Entity == nil {
+		return nil, fmt.Errorf("entity not found")
+	}
+
+	for _, secret := range result.Entity.Secrets {
+		if secret.Name == secretName {
+			return &secret, nil
+		}
+	}
+
+	return nil, errSecretNotFound
+}
+
+// parseArtifactPath parses an artifact reference of the form entity/project/name:version,
+// where version may be a version (v3) or an alias (production).
+func parseArtifactPath(artifactPath string) (ArtifactPath, error) {
+	parts := strings.Split(artifactPath, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		return ArtifactPath{}, fmt.Errorf("invalid artifact path %q, expected entity/project/name:version", artifactPath)
+	}
+	name, version, ok := strings.Cut(parts[2], ":")
+	if !ok || name == "" || version == "" {
+		return ArtifactPath{}, fmt.Errorf("invalid artifact path %q, expected entity/project/name:version", artifactPath)
+	}
+	return ArtifactPath{
+		EntityName:  parts[0],
+		ProjectName: parts[1],
+		Name:        name,
+		Version:     version,
+	}, nil
+}
+
+func (p ArtifactPath) String() string {
+	return fmt.Sprintf("%s/%s/%s:%s", p.EntityName, p.ProjectName, p.Name, p.Version)
+}
+
+func readArtifactHelper(ctx context.Context, artifactPath ArtifactPath, client *GraphQLClientWithHeaders) (*Artifact, error) {
+	gqlReq := graphql.NewRequest(`
+		query GetArtifact($entityName: String!, $projectName: String!, $name: String!) {
+			project(entityName: $entityName, name: $projectName) {
+				artifact(name: $name) {
+					id
+					digest
+					versionIndex
+					description
+					metadata
+					state
+					aliases {
+						alias
+						artifactCollectionName
+					}
+					tags {
+						name
+					}
+					artifactType {
+						name
+					}
+				}
+			}
+		}
+	`)
+	gqlReq.Var("entityName", artifactPath.EntityName)
+	gqlReq.Var("projectName", artifactPath.ProjectName)
+	gqlReq.Var("name", artifactPath.Name+":"+artifactPath.Version)
+
+	var result struct {
+		Project *struct {
+			Artifact *Artifact `json:"artifact,omitempty"`
+		} `json:"project"`
+	}
+
+	if err := client.Run(ctx, gqlReq, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Project == nil {
+		return nil, fmt.Errorf("project not found")
+	}
+
+	if result.Project.Artifact == nil {
+		return nil, fmt.Errorf("artifact not found")
+	}
+
+	return result.Project.Artifact, nil
+}
+
+// parseArtifactAliasID splits an alias ID into the artifact path and the alias. The
+// artifact path itself contains a ':', so the alias is taken from after the last one.
+func parseArtifactAliasID(id string) (string, string, error) {
+	idx := strings.LastIndex(id, ":")
+	if idx <= 0 || idx == len(id)-1 || !strings.Contains(id[:idx], ":") {
+		return "", "", fmt.Errorf("invalid composite ID: %s", id)
+	}
+	return id[:idx], id[idx+1:], nil
+}
+
+// artifactHasAlias reports whether alias is attached to the artifact within the named collection.
+func artifactHasAlias(artifact *Artifact, collectionName, alias string) bool {
+	for _, a := range artifact.Aliases {
+		if a.Alias == alias && a.ArtifactCollectionName == collectionName {
+			return true
+		}
+	}
+	return false
+}
+
+// diffStringSets returns the values that must be added to and removed from current to reach desired.
+func diffStringSets(current, desired []string) ([]string, []string) {
+	currentSet := make(map[string]bool, len(current))
+	for _, v := range current {
+		currentSet[v] = true
+	}
+	desiredSet := make(map[string]bool, len(desired))
+	for _, v := range desired {
+		desiredSet[v] = true
+	}
+
+	var added, removed []string
+	for _, v := range desired {
+		if !currentSet[v] {
+			added = append(added, v)
+		}
+	}
+	for _, v := range current {
+		if !desiredSet[v] {
+			removed = append(removed, v)
+		}
+	}
+	return added, removed
+}
+
+// registryProjectPrefix is prepended to a registry's name to form the name of the project backing it.
+const registryProjectPrefix = "wandb-registry-"
+
+// registryVisibilityToAccess maps the registry visibility exposed by the provider to the project access level.
+var registryVisibilityToAccess = map[string]string{
+	"organization": "PRIVATE",
+	"restricted":   "RESTRICTED",
+}
+
+func registryAccessToVisibility(access string) (string, error) {
+	for visibility, a := range registryVisibilityToAccess {
+		if a == access {
+			return visibility, nil
+		}
+	}
+	return "", fmt.Errorf("unknown registry access: %s", access)
+}
+
+// errRegistryNotFound is returned when a registry does not exist or has been deleted.
+var errRegistryNotFound = errors.New("registry not found")
+
+func readRegistryHelper(ctx context.Context, entityName, registryName string, client *GraphQLClientWithHeaders) (*Registry, error) {
+	if entityName == "" || registryName == "" {
+		return nil, fmt.Errorf("entity_name and name must be specified")
+	}
+
+	gqlReq := graphql.NewRequest(`
+		query GetRegistry($entityName: String!, $projectName: String!) {
+			project(entityName: $entityName, name: $projectName) {
+				id
+				name
+				entityName
+				description
+				access
+				allowAllArtifactTypesInRegistry
+				artifactTypes(includeAll: true) {
+					edges {
+						node {
+							name
+						}
+					}
+				}
+				members {
+					id
+					username
+					role {
+						name
+					}
+				}
+			}
+		}
+	`)
+	gqlReq.Var("entityName", entityName)
+	gqlReq.Var("projectName", registryProjectPrefix+registryName)
+
+	var result struct {
+		Project *Registry `json:"project,omitempty"`
+	}
+
+	if err := client.Run(ctx, gqlReq, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Project == nil {
+		return nil, errRegistryNotFound
+	}
+
+	return result.Project, nil
+}
+
+// generateRegistryCollectionID generates a composite ID from entityName, registryName and collectionName.
+func generateRegistryCollectionID(entityName, registryName, collectionName string) string {
+	return fmt.Sprintf("%s:%s:%s", entityName, registryName, collectionName)
+}
+
+// parseRegistryCollectionID parses a composite ID into entityName, registryName and collectionName.
+func parseRegistryCollectionID(compositeID string) (string, string, string, error) {
+	parts := strings.SplitN(compositeID, ":", 3)
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("invalid composite ID: %s", compositeID)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// errRegistryCollectionNotFound is returned when a registry has no collection with the requested name.
+var errRegistryCollectionNotFound = errors.New("registry collection not found")
+
+func readRegistryCollectionHelper(ctx context.Context, entityName, registryName, collectionName string, client *GraphQLClientWithHeaders) (*ArtifactCollection, error) {
+	if entityName == "" || registryName == "" || collectionName == "" {
+		return nil, fmt.Errorf("entity_name, registry and name must be specified")
+	}
+
+	gqlReq := graphql.NewRequest(`
+		query GetRegistryCollection($entityName: String!, $projectName: String!, $name: String!) {
+			project(entityName: $entityName, name: $projectName) {
+				artifactCollection(name: $name) {
+					id
+					name
+					description
+					tags {
+						edges {
+							node {
+								name
+							}
+						}
+					}
+					defaultArtifactType {
+						name
+					}
+				}
+			}
+		}
+	`)
+	gqlReq.Var("entityName", entityName)
+	gqlReq.Var("projectName", registryProjectPrefix+registryName)
+	gqlReq.Var("name", collectionName)
+
+	var result struct {
+		Project *struct {
+			ArtifactCollection *ArtifactCollection `json:"artifactCollection,omitempty"`
+		} `json:"project"`
+	}
+
+	if err := client.Run(ctx, gqlReq, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Project == nil {
+		return nil, errRegistryNotFound
+	}
+
+	if result.Project.ArtifactCollection == nil {
+		return nil, errRegistryCollectionNotFound
+	}
+
+	return result.Project.ArtifactCollection, nil
+}
+
+// automationEventTypes maps the event types exposed by the provider to the API's triggering event types.
+var automationEventTypes = map[string]string{
+	"artifact_alias_added": "ADD_ARTIFACT_ALIAS",
+	"artifact_linked":      "LINK_MODEL",
+	"run_metric_threshold": "RUN_METRIC",
+}
+
+// automationMetricOperators maps comparison operators to the API's metric filter operators.
+var automationMetricOperators = map[string]string{
+	">":  "$gt",
+	">=": "$gte",
+	"<":  "$lt",
+	"<=": "$lte",
+}
+
+// buildAutomationEventFilter builds the JSON event filter for an automation event. aliasRegex only
+// applies to artifact_alias_added events and metric only to run_metric_threshold events.
+func buildAutomationEventFilter(eventType, aliasRegex string, metric *AutomationMetricFilter) (string, error) {
+	var filter interface{}
+
+	switch eventType {
+	case "artifact_alias_added":
+		if aliasRegex == "" {
+			aliasRegex = ".*"
+		}
+		filter = map[string]interface{}{
+			"$or": []interface{}{
+				map[string]interface{}{
+					"$and": []interface{}{
+						map[string]interface{}{"alias": map[string]string{"$regex": aliasRegex}},
+					},
+				},
+			},
+		}
+	case "artifact_linked":
+		filter = map[string]interface{}{}
+	case "run_metric_threshold":
+		if metric == nil {
+			return "", fmt.Errorf("metric must be specified for run_metric_threshold events")
+		}
+		cmpOp, ok := automationMetricOperators[metric.CmpOp]
+		if !ok {
+			return "", fmt.Errorf("unsupported metric operator: %s", metric.CmpOp)
+		}
+		apiMetric := *metric
+		apiMetric.CmpOp = cmpOp
+		filter = map[string]interface{}{
+			"run_filter":    map[string]interface{}{},
+			"metric_filter": apiMetric,
+		}
+	default:
+		return "", fmt.Errorf("unsupported event type: %s", eventType)
+	}
+
+	filterBytes, err := json.Marshal(filter)
+	if err != nil {
+		return "", err
+	}
+	return string(filterBytes), nil
+}
+
+// parseAutomationID parses an automation import ID into the entity name, the name of the project
+// the automation is scoped to and the automation ID.
+func parseAutomationID(compositeID string) (string, string, string, error) {
+	parts := strings.SplitN(compositeID, ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("invalid composite ID: %s", compositeID)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// parseAutomationEventFilter parses the JSON event filter of an automation, as built by
+// buildAutomationEventFilter, into the event type exposed by the provider, the alias regex of
+// artifact_alias_added events and the metric of run_metric_threshold events.
+func parseAutomationEventFilter(apiEventType, filter string) (string, string, *AutomationMetricFilter, error) {
+	var eventType string
+	for name, apiName := range automationEventTypes {
+		if apiName == apiEventType {
+			eventType = name
+		}
+	}
+
+	switch eventType {
+	case "artifact_alias_added":
+		var decoded struct {
+			Or []struct {
+				And []struct {
+					Alias *struct {
+						Regex string `json:"$regex"`
+					} `json:"alias"`
+				} `json:"$and"`
+			} `json:"$or"`
+		}
+		if err := json.Unmarshal([]byte(filter), &decoded); err != nil {
+			return "", "", nil, fmt.Errorf("invalid event filter: %w", err)
+		}
+		for _, or := range decoded.Or {
+			for _, and := range or.And {
+				if and.Alias != nil {
+					return eventType, and.Alias.Regex, nil, nil
+				}
+			}
+		}
+		return eventType, "", nil, nil
+	case "artifact_linked":
+		return eventType, "", nil, nil
+	case "run_metric_threshold":
+		var decoded struct {
+			MetricFilter *AutomationMetricFilter `json:"metric_filter"`
+		}
+		if err := json.Unmarshal([]byte(filter), &decoded); err != nil {
+			return "", "", nil, fmt.Errorf("invalid event filter: %w", err)
+		}
+		if decoded.MetricFilter == nil {
+			return "", "", nil, fmt.Errorf("event filter has no metric filter")
+		}
+		metric := *decoded.MetricFilter
+		for operator, apiOperator := range automationMetricOperators {
+			if apiOperator == metric.CmpOp {
+				metric.CmpOp = operator
+			}
+		}
+		return eventType, "", &metric, nil
+	default:
+		return "", "", nil, fmt.Errorf("unsupported triggering event type: %s", apiEventType)
+	}
+}
+
+func readProjectIDHelper(ctx context.Context, entityName, projectName string, client *GraphQLClientWithHeaders) (string, error) {
+	gqlReq := graphql.NewRequest(`
+		query GetProjectID($entityName: String!, $projectName: String!) {
+			project(entityName: $entityName, name: $projectName) {
+				id
+			}
+		}
+	`)
+	gqlReq.Var("entityName", entityName)
+	gqlReq.Var("projectName", projectName)
+
+	var result struct {
+		Project *struct {
+			ID string `json:"id"`
+		} `json:"project"`
+	}
+
+	if err := client.Run(ctx, gqlReq, &result); err != nil {
+		return "", err
+	}
+
+	if result.Project == nil {
+		return "", fmt.Errorf("project not found")
+	}
+
+	return result.Project.ID, nil
+}
+
+// errAutomationNotFound is returned when a project has no automation with the requested ID.
+var errAutomationNotFound = errors.New("automation not found")
+
+func readTriggerHelper(ctx context.Context, entityName, projectName, triggerID string, client *GraphQLClientWithHeaders) (*Trigger, error) {
+	gqlReq := graphql.NewRequest(`
+		query GetTriggers($entityName: String!, $projectName: String!) {
+			project(entityName: $entityName, name: $projectName) {
+				triggers {
+					id
+					name
+					description
+					enabled
+					scope {
+						__typename
+						... on Project {
+							name
+							entity {
+								name
+							}
+						}
+					}
+					triggeringCondition {
+						... on FilterEventTriggeringCondition {
+							eventType
+							filter
+						}
+					}
+					triggeredAction {
+						__typename
+						... on QueueJobTriggeredAction {
+							queue {
+								name
+								entityName
+							}
+							template
+						}
+						... on GenericWebhookTriggeredAction {
+							integration {
+								... on GenericWebhookIntegration {
+									id
+								}
+							}
+							requestPayload
+						}
+						... on NotificationTriggeredAction {
+							integration {
+								... on SlackIntegration {
+									id
+								}
+							}
+							title
+							message
+							severity
+						}
+					}
+				}
+			}
+		}
+	`)
+	gqlReq.Var("entityName", entityName)
+	gqlReq.Var("projectName", projectName)
+
+	var result struct {
+		Project *struct {
+			Triggers []Trigger `json:"triggers"`
+		} `json:"project"`
+	}
+
+	if err := client.Run(ctx, gqlReq, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Project == nil {
+		return nil, fmt.Errorf("project not found")
+	}
+
+	for _, trigger := range result.Project.Triggers {
+		if trigger.ID == triggerID {
+			return &trigger, nil
+		}
+	}
+
+	return nil, errAutomationNotFound
+}
+
+func readIntegrationsHelper(ctx context.Context, entityName string, client *GraphQLClientWithHeaders) ([]Integration, error) {
+	gqlReq := graphql.NewRequest(`
+		query GetIntegrations($entityName: String!) {
+			entity(name: $entityName) {
+				integrations {
+					edges {
+						node {
+							__typename
+							... on GenericWebhookIntegration {
+								id
+								name
+								urlEndpoint
+								secretRef
+								accessTokenRef
+								createdAt
+							}
+							... on SlackIntegration {
+								id
+								teamName
+								channelName
+								createdAt
+							}
+						}
+					}
+				}
+			}
+		}
+	`)
+	gqlReq.Var("entityName", entityName)
+
+	var result struct {
+		Entity *struct {
+			Integrations struct {
+				Edges []struct {
+					Node Integration `json:"node"`
+				} `json:"edges"`
+			} `json:"integrations"`
+		} `json:"entity"`
+	}
+
+	if err := client.Run(ctx, gqlReq, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Entity == nil {
+		return nil, fmt.Errorf("entity not found")
+	}
+
+	integrations := make([]Integration, 0, len(result.Entity.Integrations.Edges))
+	for _, edge := range result.Entity.Integrations.Edges {
+		integrations = append(integrations, edge.Node)
+	}
+	return integrations, nil
+}
+
+// errIntegrationNotFound is returned when an entity has no integration of the requested type with the requested ID.
+var errIntegrationNotFound = errors.New("integration not found")
+
+func readIntegrationHelper(ctx context.Context, entityName, integrationID, typename string, client *GraphQLClientWithHeaders) (*Integration, error) {
+	integrations, err := readIntegrationsHelper(ctx, entityName, client)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, integration := range integrations {
+		if integration.ID == integrationID && integration.Typename == typename {
+			return &integration, nil
+		}
+	}
+
+	return nil, errIntegrationNotFound
+}
+
+// validateWebhookURL checks that a webhook URL is absolute and uses the http or https scheme.
+func validateWebhookURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("URL scheme must be http or https, got: %q", parsed.Scheme)
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("URL must include a host")
+	}
+	return nil
+}
+
+// deleteIntegration deletes a webhook or Slack integration by ID.
+func deleteIntegration(ctx context.Context, integrationID string, client *GraphQLClientWithHeaders) (bool, error) {
+	gqlReq := graphql.NewRequest(`
+		mutation DeleteIntegration($id: ID!) {
+			deleteIntegration(input: {id: $id}) {
+				success
+			}
+		}
+	`)
+	gqlReq.Var("id", integrationID)
+
+	var result struct {
+		DeleteIntegration struct {
+			Success bool `json:"success"`
+		} `json:"deleteIntegration"`
+	}
+
+	if err := client.Run(ctx, gqlReq, &result); err != nil {
+		return false, err
+	}
+	return result.DeleteIntegration.Success, nil
+}
+
+// sweepStates maps the sweep states that can be configured to their API values.
+var sweepStates = map[string]string{
+	"running":  "RUNNING",
+	"paused":   "PAUSED",
+	"finished": "FINISHED",
+}
+
+// sweepStateFromAPI maps an API sweep state to a configurable state. Pending sweeps are waiting for an
+// agent or launch scheduler to start them and are reported as running.
+func sweepStateFromAPI(state string) (string, error) {
+	switch state {
+	case "PENDING", "RUNNING":
+		return "running", nil
+	case "PAUSED":
+		return "paused", nil
+	case "FINISHED", "CANCELED", "CANCELLED":
+		return "finished", nil
+	default:
+		return "", fmt.Errorf("unsupported sweep state: %s", state)
+	}
+}
+
+// decodeSweepParameterValue decodes a sweep parameter value given as a string. Values that are valid
+// JSON, such as numbers, booleans and lists, are decoded so the sweep config receives the typed value.
+func decodeSweepParameterValue(value string) interface{} {
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return value
+	}
+	return decoded
+}
+
+// validateSweepConfigYAML checks that a raw sweep config is a YAML mapping.
+func validateSweepConfigYAML(config string) error {
+	var decoded map[string]interface{}
+	if err := yaml.Unmarshal([]byte(config), &decoded); err != nil {
+		return err
+	}
+	if len(decoded) == 0 {
+		return fmt.Errorf("sweep config must not be empty")
+	}
+	return nil
+}
+
+func generateSweepID(entityName, projectName, sweepName string) string {
+	return fmt.Sprintf("%s:%s:%s", entityName, projectName, sweepName)
+}
+
+// parseSweepID parses a composite ID into entityName, projectName and sweepName.
+func parseSweepID(compositeID string) (string, string, string, error) {
+	parts := strings.SplitN(compositeID, ":", 3)
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("invalid composite ID: %s", compositeID)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// errSweepNotFound is returned when a project has no sweep with the requested name.
+var errSweepNotFound = errors.New("sweep not found")
+
+func readSweepHelper(ctx context.Context, entityName, projectName, sweepName string, client *GraphQLClientWithHeaders) (*Sweep, error) {
+	if entityName == "" || projectName == "" || sweepName == "" {
+		return nil, fmt.Errorf("entity_name, project_name and sweep name must be specified")
+	}
+
+	gqlReq := graphql.NewRequest(`
+		query GetSweep($entityName: String!, $projectName: String!, $sweepName: String!) {
+			project(name: $projectName, entityName: $entityName) {
+				sweep(sweepName: $sweepName) {
+					id
+					name
+					state
+					description
+					config
+				}
+			}
+		}
+	`)
+	gqlReq.Var("entityName", entityName)
+	gqlReq.Var("projectName", projectName)
+	gqlReq.Var("sweepName", sweepName)
+
+	var result struct {
+		Project *struct {
+			Sweep *Sweep `json:"sweep"`
+		} `json:"project"`
+	}
+
+	if err := client.Run(ctx, gqlReq, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Project == nil {
+		return nil, fmt.Errorf("project not found")
+	}
+
+	if result.Project.Sweep == nil {
+		return nil, errSweepNotFound
+	}
+
+	return result.Project.Sweep, nil
+}
+
+// launchJobSourceTypes maps the source types recorded in job artifact metadata to the names exposed
+// by the provider.
+var launchJobSourceTypes = map[string]string{
+	"image":    "image",
+	"repo":     "git",
+	"artifact": "code_artifact",
+}
+
+// parseLaunchJobMetadata extracts the source type and the input schema, as a JSON string, from the
+// metadata of a job artifact. Either value is nil if the job was created without it.
+func parseLaunchJobMetadata(metadata *string) (*string, *string, error) {
+	if metadata == nil || *metadata == "" {
+		return nil, nil, nil
+	}
+
+	var decoded struct {
+		SourceType  string          `json:"source_type"`
+		InputTypes  json.RawMessage `json:"input_types"`
+		InputSchema json.RawMessage `json:"input_schemas"`
+	}
+	if err := json.Unmarshal([]byte(*metadata), &decoded); err != nil {
+		return nil, nil, err
+	}
+
+	var sourceType *string
+	if decoded.SourceType != "" {
+		mapped, ok := launchJobSourceTypes[decoded.SourceType]
+		if !ok {
+			return nil, nil, fmt.Errorf("unsupported job source type: %s", decoded.SourceType)
+		}
+		sourceType = &mapped
+	}
+
+	// Newer SDKs record a JSON schema of the job inputs, older ones only the W&B input types.
+	schema := decoded.InputSchema
+	if len(schema) == 0 || string(schema) == "null" {
+		schema = decoded.InputTypes
+	}
+	var inputSchema *string
+	if len(schema) != 0 && string(schema) != "null" {
+		schemaString := string(schema)
+		compacted, err := normalizeJSONObject(&schemaString)
+		if err != nil {
+			return nil, nil, err
+		}
+		inputSchema = compacted
+	}
+
+	return sourceType, inputSchema, nil
+}
+
+// runQueuePriorities maps the run queue item priorities that can be configured to their API values.
+// Lower values are dequeued first.
+var runQueuePriorities = map[string]int{
+	"critical": 0,
+	"high":     1,
+	"medium":   2,
+	"low":      3,
+}
+
+// runQueuePriorityName maps an API run queue priority to its configurable name.
+func runQueuePriorityName(priority int) (string, error) {
+	for name, value := range runQueuePriorities {
+		if value == priority {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported run queue priority: %d", priority)
+}
+
+// resolveTemplateVariableValues checks the given template variable values against the template
+// variables defined on a run queue and converts each value to the type declared by its schema.
+func resolveTemplateVariableValues(values map[string]string, templateVariables []TemplateVariableWithName) (map[string]interface{}, error) {
+	definitions, err := templateVarsWithNamesListToMap(templateVariables)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := make(map[string]interface{}, len(values))
+	for name, value := range values {
+		definition, ok := definitions[name]
+		if !ok {
+			return nil, fmt.Errorf("template variable %q is not defined on the run queue", name)
+		}
+
+		schema := definition.Schema
+		if len(schema.Enum) > 0 && !slices.Contains(schema.Enum, value) {
+			return nil, fmt.Errorf("template variable %q must be one of: %s", name, strings.Join(schema.Enum, ", "))
+		}
+
+		switch schema.Type {
+		case "integer", "number":
+			number, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("template variable %q must be a %s: %w", name, schema.Type, err)
+			}
+			if schema.Type == "integer" && number != math.Trunc(number) {
+				return nil, fmt.Errorf("template variable %q must be an integer", name)
+			}
+			if minimum, ok := schema.Minimum.(float64); ok && number < minimum {
+				return nil, fmt.Errorf("template variable %q must be at least %v", name, minimum)
+			}
+			if maximum, ok := schema.Maximum.(float64); ok && number > maximum {
+				return nil, fmt.Errorf("template variable %q must be at most %v", name, maximum)
+			}
+			if schema.Type == "integer" {
+				resolved[name] = int64(number)
+			} else {
+				resolved[name] = number
+			}
+		default:
+			resolved[name] = value
+		}
+	}
+
+	return resolved, nil
+}
+
+func generateRunQueueItemID(entityName, queueName, itemID string) string {
+	return fmt.Sprintf("%s:%s:%s", entityName, queueName, itemID)
+}
+
+// parseRunQueueItemID parses a composite ID into entityName, queueName and itemID.
+func parseRunQueueItemID(compositeID string) (string, string, string, error) {
+	parts := strings.SplitN(compositeID, ":", 3)
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("invalid composite ID: %s", compositeID)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// errRunQueueItemNotFound is returned by readRunQueueItemHelper when the item, or its run queue, no
+// longer exists.
+var errRunQueueItemNotFound = errors.New("run queue item not found")
+
+// runQueueItemsPageSize is the number of run queue items requested per page.
+const runQueueItemsPageSize = 100
+
+func readRunQueueItemHelper(ctx context.Context, entityName, queueName, itemID string, client *GraphQLClientWithHeaders) (*RunQueueItem, error) {
+	var cursor *string
+	for {
+		gqlReq := graphql.NewRequest(`
+			query GetRunQueueItems($entityName: String!, $projectName: String!, $queueName: String!, $first: Int, $after: String) {
+				project(entityName: $entityName, name: $projectName) {
+					runQueue(name: $queueName) {
+						runQueueItems(first: $first, after: $after) {
+							pageInfo {
+								hasNextPage
+								endCursor
+							}
+							edges {
+								node {
+									id
+									state
+									associatedRunId
+									createdAt
+								}
+							}
+						}
+					}
+				}
+			}
+		`)
+		gqlReq.Var("entityName", entityName)
+		gqlReq.Var("queueName", queueName)
+		gqlReq.Var("projectName", "model-registry")
+		gqlReq.Var("first", runQueueItemsPageSize)
+		gqlReq.Var("after", cursor)
+
+		var result struct {
+			Project struct {
+				RunQueue *struct {
+					RunQueueItems struct {
+						PageInfo struct {
+							HasNextPage bool    `json:"hasNextPage"`
+							EndCursor   *string `json:"endCursor"`
+						} `json:"pageInfo"`
+						Edges []struct {
+							Node RunQueueItem `json:"node"`
+						} `json:"edges"`
+					} `json:"runQueueItems"`
+				} `json:"runQueue"`
+			} `json:"project"`
+		}
+
+		if err := client.Run(ctx, gqlReq, &result); err != nil {
+			return nil, err
+		}
+
+		// The items of a deleted run queue are deleted with it.
+		if result.Project.RunQueue == nil {
+			return nil, errRunQueueItemNotFound
+		}
+
+		items := result.Project.RunQueue.RunQueueItems
+		for _, edge := range items.Edges {
+			if edge.Node.ID == itemID {
+				return &edge.Node, nil
+			}
+		}
+
+		if !items.PageInfo.HasNextPage || items.PageInfo.EndCursor == nil {
+			return nil, errRunQueueItemNotFound
+		}
+		cursor = items.PageInfo.EndCursor
+	}
+}
+
+// storageBucketProviders maps the storage providers that can be configured to their API values.
+var storageBucketProviders = map[string]string{
+	"s3":    "AWS",
+	"gcs":   "GCP",
+	"azure": "AZURE",
+}
+
+// storageBucketProviderFromAPI maps an API storage provider to its configurable name.
+func storageBucketProviderFromAPI(provider string) (string, error) {
+	for name, value := range storageBucketProviders {
+		if strings.EqualFold(value, provider) {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported storage provider: %s", provider)
+}
+
+// errStorageBucketNotFound is returned when an entity has no storage bucket configured.
+var errStorageBucketNotFound = errors.New("storage bucket not found")
+
+func readStorageBucketHelper(ctx context.Context, entityName string, client *GraphQLClientWithHeaders) (*StorageBucketInfo, error) {
+	gqlReq := graphql.NewRequest(`
+		query GetEntityStorageBucket($entityName: String!) {
+			entity(name: $entityName) {
+				storageBucketInfo {
+					provider
+					name
+					path
+					kmsKeyId
+					region
+				}
+			}
+		}
+	`)
+	gqlReq.Var("entityName", entityName)
+
+	var result struct {
+		Entity *struct {
+			StorageBucketInfo *StorageBucketInfo `json:"storageBucketInfo"`
+		} `json:"entity"`
+	}
+
+	if err := client.Run(ctx, gqlReq, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Entity == nil {
+		return nil, fmt.Errorf("entity not found")
+	}
+
+	if result.Entity.StorageBucketInfo == nil {
+		return nil, errStorageBucketNotFound
+	}
+
+	return result.Entity.StorageBucketInfo, nil
+}
+
+// testStorageBucketHelper asks the API to check that W&B can access the bucket with the given
+// settings, returning the problems it found.
+func testStorageBucketHelper(ctx context.Context, bucket StorageBucketInfo, client *GraphQLClientWithHeaders) ([]StorageBucketCheck, error) {
+	gqlReq := graphql.NewRequest(`
+		query TestBucketStoreConnection($input: StorageBucketInfoInput!) {
+			testBucketStoreConnection(input: $input) {
+				severity
+				message
+			}
+		}
+	`)
+	gqlReq.Var("input", bucket)
+
+	var result struct {
+		TestBucketStoreConnection []StorageBucketCheck `json:"testBucketStoreConnection"`
+	}
+
+	if err := client.Run(ctx, gqlReq, &result); err != nil {
+		return nil, err
+	}
+
+	return result.TestBucketStoreConnection, nil
+}
+
+// stringPointerValueOrNull converts an optional API string to a Terraform value, treating the empty
+// string the API returns for cleared fields as null.
+func stringPointerValueOrNull(value *string) types.String {
+	if value == nil || *value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*value)
+}
+
+func readOrganizationHelper(ctx context.Context, organizationName string, client *GraphQLClientWithHeaders) (*Organization, error) {
+	gqlReq := graphql.NewRequest(`
+		query GetOrganization($name: String!) {
+			organization(name: $name) {
+				id
+				name
+				settings {
+					defaultTeamPrivacy
+					allowedEmailDomains
+					codeSavingEnabled
+					hidePublicProjects
+				}
+			}
+		}
+	`)
+	gqlReq.Var("name", organizationName)
+
+	var result struct {
+		Organization *Organization `json:"organization"`
+	}
+
+	if err := client.Run(ctx, gqlReq, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Organization == nil {
+		return nil, fmt.Errorf("organization not found")
+	}
+
+	return result.Organization, nil
+}
+
+// customRoleBaseRoles maps the roles a custom role can inherit from to their API values.
+var customRoleBaseRoles = map[string]string{
+	"viewer": "VIEWER",
+	"member": "MEMBER",
+}
+
+// customRoleBaseRoleFromAPI maps an API role name to the base role it corresponds to.
+func customRoleBaseRoleFromAPI(role string) (string, error) {
+	for name, value := range customRoleBaseRoles {
+		if strings.EqualFold(value, role) {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported base role: %s", role)
+}
+
+// errCustomRoleNotFound is returned when an organization has no custom role with the requested ID.
+var errCustomRoleNotFound = errors.New("custom role not found")
+
+func readCustomRoleHelper(ctx context.Context, organizationName, roleID string, client *GraphQLClientWithHeaders) (*CustomRole, error) {
+	gqlReq := graphql.NewRequest(`
+		query GetOrganizationRoles($name: String!) {
+			organization(name: $name) {
+				roles {
+					id
+					name
+					description
+					inheritedFrom {
+						name
+					}
+					permissions {
+						name
+					}
+				}
+			}
+		}
+	`)
+	gqlReq.Var("name", organizationName)
+
+	var result struct {
+		Organization *struct {
+			Roles []CustomRole `json:"roles"`
+		} `json:"organization"`
+	}
+
+	if err := client.Run(ctx, gqlReq, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Organization == nil {
+		return nil, fmt.Errorf("organization not found")
+	}
+
+	for _, role := range result.Organization.Roles {
+		if role.ID == roleID {
+			return &role, nil
+		}
+	}
+
+	return nil, errCustomRoleNotFound
+}
+
+func readRolePermissionsHelper(ctx context.Context, organizationName string, client *GraphQLClientWithHeaders) ([]RolePermission, error) {
+	gqlReq := graphql.NewRequest(`
+		query GetOrganizationRolePermissions($name: String!) {
+			organization(name: $name) {
+				availablePermissions {
+					name
+					displayName
+					description
+					group
+				}
+			}
+		}
+	`)
+	gqlReq.Var("name", organizationName)
+
+	var result struct {
+		Organization *struct {
+			AvailablePermissions []RolePermission `json:"availablePermissions"`
+		} `json:"organization"`
+	}
+
+	if err := client.Run(ctx, gqlReq, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Organization == nil {
+		return nil, fmt.Errorf("organization not found")
+	}
+
+	return result.Organization.AvailablePermissions, nil
+}
+
+// isSCIMNotFound reports whether err is a SCIM API response with a 404 status.
+func isSCIMNotFound(err error) bool {
+	var scimErr *SCIMError
+	return errors.As(err, &scimErr) && scimErr.StatusCode == http.StatusNotFound
+}
+
+// errSCIMUserNotFound and errSCIMGroupNotFound wrap the 404 responses of the SCIM API for users and
+// groups that do not exist.
+var (
+	errSCIMUserNotFound  = errors.New("scim user not found")
+	errSCIMGroupNotFound = errors.New("scim group not found")
+)
+
+func readSCIMUserHelper(ctx context.Context, userID string, client *GraphQLClientWithHeaders) (*SCIMUser, error) {
+	var user SCIMUser
+	if err := client.RunSCIM(ctx, http.MethodGet, "/Users/"+url.PathEscape(userID), nil, &user); err != nil {
+		if isSCIMNotFound(err) {
+			return nil, fmt.Errorf("%w: %w", errSCIMUserNotFound, err)
+		}
+		return nil, err
+	}
+	return &user, nil
+}
+
+func readSCIMGroupHelper(ctx context.Context, groupID string, client *GraphQLClientWithHeaders) (*SCIMGroup, error) {
+	var group SCIMGroup
+	if err := client.RunSCIM(ctx, http.MethodGet, "/Groups/"+url.PathEscape(groupID), nil, &group); err != nil {
+		if isSCIMNotFound(err) {
+			return nil, fmt.Errorf("%w: %w", errSCIMGroupNotFound, err)
+		}
+		return nil, err
+	}
+	return &group, nil
+}
+
+// scimGroupMemberOperations returns the SCIM patch operations that change a group's members from
+// current to desired.
+func scimGroupMemberOperations(current, desired []string) []SCIMPatchOperation {
+	added, removed := diffStringSets(current, desired)
+
+	var operations []SCIMPatchOperation
+	if len(added) > 0 {
+		members := make([]SCIMGroupMember, 0, len(added))
+		for _, userID := range added {
+			members = append(members, SCIMGroupMember{Value: userID})
+		}
+		operations = append(operations, SCIMPatchOperation{Op: "add", Path: "members", Value: members})
+	}
+	for _, userID := range removed {
+		operations = append(operations, SCIMPatchOperation{Op: "remove", Path: fmt.Sprintf("members[value eq %q]", userID)})
+	}
+	return operations
+}
+
+// readViewerHelper returns the authenticated user and the version of the server, which is empty for
+// W&B Multi-tenant Cloud as it always runs the latest version.
+func readViewerHelper(ctx context.Context, client *GraphQLClientWithHeaders) (*User, string, error) {
+	gqlReq := graphql.NewRequest(`
+		query Viewer {
+			viewer {
+				id
+				username
+				email
+				admin
+			}
+			serverInfo {
+				latestLocalVersionInfo {
+					versionOnThisInstanceString
+				}
+			}
+		}
+	`)
+
+	var result struct {
+		Viewer     *User       `json:"viewer"`
+		ServerInfo *ServerInfo `json:"serverInfo"`
+	}
+
+	if err := client.Run(ctx, gqlReq, &result); err != nil {
+		return nil, "", err
+	}
+
+	if result.Viewer == nil {
+		return nil, "", fmt.Errorf("the API key is not associated with a user")
+	}
+
+	var serverVersion string
+	if result.ServerInfo != nil && result.ServerInfo.LatestLocalVersionInfo != nil {
+		serverVersion = result.ServerInfo.LatestLocalVersionInfo.VersionOnThisInstanceString
+	}
+
+	return result.Viewer, serverVersion, nil
+}
+
+// defaultOperationTimeout is the maximum time a create, read, update or delete operation can take
+// when its timeout is not configured in the resource's timeouts block.
+const defaultOperationTimeout = 20 * time.Minute
+
+// withOperationTimeout returns a context that is cancelled when the configured timeout of an operation
+// expires. timeout is the timeouts block method of the operation, such as data.Timeouts.Create. If the
+// timeout cannot be parsed, an error is appended to diags and the default is used, so callers must
+// check diags before running the operation.
+func withOperationTimeout(ctx context.Context, timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics), diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	duration, timeoutDiags := timeout(ctx, defaultOperationTimeout)
+	diags.Append(timeoutDiags...)
+	return context.WithTimeout(ctx, duration)
+}
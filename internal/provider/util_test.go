@@ -1,9 +1,13 @@
 package provider
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -119,3 +123,390 @@ func TestInjectResourceArgsAndResourceFields_AlreadyPresent(t *testing.T) {
 	_, err := injectResourceArgsAndResourceFields(resourceConfig, resourceType)
 	assert.Error(t, err, "invalid resource_config, resource_config should be provided as a map of arguments for the resource or a kubernetes job spec. See details for specific resource here: https://docs.wandb.ai/guides/launch/setup-launch")
 }
+
+func TestTeamConnectionToList(t *testing.T) {
+	teams := TeamConnection{
+		Edges: []TeamEdge{
+			{Node: TeamNode{Name: "team-a"}},
+			{Node: TeamNode{Name: "team-b"}},
+		},
+	}
+
+	expected := []attr.Value{
+		types.StringValue("team-a"),
+		types.StringValue("team-b"),
+	}
+
+	result, diags := teamConnectionToList(teams)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, expected, result.Elements())
+}
+
+func TestParseArtifactPath(t *testing.T) {
+	result, err := parseArtifactPath("example-entity/example-project/example-model:v3")
+	assert.NoError(t, err)
+	assert.Equal(t, ArtifactPath{
+		EntityName:  "example-entity",
+		ProjectName: "example-project",
+		Name:        "example-model",
+		Version:     "v3",
+	}, result)
+	assert.Equal(t, "example-entity/example-project/example-model:v3", result.String())
+}
+
+func TestParseArtifactPath_InvalidFormat(t *testing.T) {
+	for _, artifactPath := range []string{
+		"example-model:v3",
+		"example-project/example-model:v3",
+		"example-entity/example-project/example-model",
+		"example-entity/example-project/example-model:",
+		"example-entity/example-project/nested/example-model:v3",
+	} {
+		_, err := parseArtifactPath(artifactPath)
+		assert.Error(t, err, artifactPath)
+	}
+}
+
+func TestParseArtifactAliasID(t *testing.T) {
+	artifactPath, alias, err := parseArtifactAliasID("example-entity/example-project/example-model:v3:production")
+	assert.NoError(t, err)
+	assert.Equal(t, "example-entity/example-project/example-model:v3", artifactPath)
+	assert.Equal(t, "production", alias)
+}
+
+func TestParseArtifactAliasID_InvalidFormat(t *testing.T) {
+	for _, id := range []string{
+		"example-entity/example-project/example-model:v3",
+		"example-entity/example-project/example-model:v3:",
+	} {
+		_, _, err := parseArtifactAliasID(id)
+		assert.Error(t, err, id)
+	}
+}
+
+func TestArtifactHasAlias(t *testing.T) {
+	artifact := &Artifact{
+		Aliases: []ArtifactAlias{
+			{Alias: "production", ArtifactCollectionName: "example-model"},
+			{Alias: "staging", ArtifactCollectionName: "other-model"},
+		},
+	}
+
+	assert.True(t, artifactHasAlias(artifact, "example-model", "production"))
+	assert.False(t, artifactHasAlias(artifact, "example-model", "staging"))
+}
+
+func TestDiffStringSets(t *testing.T) {
+	added, removed := diffStringSets([]string{"a", "b"}, []string{"b", "c"})
+	assert.Equal(t, []string{"c"}, added)
+	assert.Equal(t, []string{"a"}, removed)
+}
+
+func TestRegistryAccessToVisibility(t *testing.T) {
+	visibility, err := registryAccessToVisibility("RESTRICTED")
+	assert.NoError(t, err)
+	assert.Equal(t, "restricted", visibility)
+
+	visibility, err = registryAccessToVisibility("PRIVATE")
+	assert.NoError(t, err)
+	assert.Equal(t, "organization", visibility)
+
+	_, err = registryAccessToVisibility("USER_WRITE")
+	assert.Error(t, err)
+}
+
+func TestGenerateRegistryCollectionID(t *testing.T) {
+	expected := "example-entity:example-registry:example-collection"
+	result := generateRegistryCollectionID("example-entity", "example-registry", "example-collection")
+	assert.Equal(t, expected, result)
+}
+
+func TestParseRegistryCollectionID(t *testing.T) {
+	entityName, registryName, collectionName, err := parseRegistryCollectionID("example-entity:example-registry:example-collection")
+	assert.NoError(t, err)
+	assert.Equal(t, "example-entity", entityName)
+	assert.Equal(t, "example-registry", registryName)
+	assert.Equal(t, "example-collection", collectionName)
+
+	_, _, _, err = parseRegistryCollectionID("example-entity:example-registry")
+	assert.Error(t, err)
+}
+
+func TestBuildAutomationEventFilter(t *testing.T) {
+	filter, err := buildAutomationEventFilter("artifact_alias_added", "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"$or":[{"$and":[{"alias":{"$regex":".*"}}]}]}`, filter)
+
+	filter, err = buildAutomationEventFilter("artifact_linked", "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `{}`, filter)
+
+	filter, err = buildAutomationEventFilter("run_metric_threshold", "", &AutomationMetricFilter{
+		Name:       "loss",
+		WindowSize: 5,
+		AggOp:      "AVERAGE",
+		CmpOp:      "<=",
+		Threshold:  0.1,
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, filter, `"cmp_op":"$lte"`)
+	assert.Contains(t, filter, `"window_size":5`)
+
+	_, err = buildAutomationEventFilter("run_metric_threshold", "", nil)
+	assert.Error(t, err)
+
+	_, err = buildAutomationEventFilter("run_metric_threshold", "", &AutomationMetricFilter{Name: "loss", CmpOp: "=="})
+	assert.Error(t, err)
+
+	_, err = buildAutomationEventFilter("run_state_changed", "", nil)
+	assert.Error(t, err)
+}
+
+func TestParseAutomationEventFilter(t *testing.T) {
+	filter, err := buildAutomationEventFilter("artifact_alias_added", "staging|production", nil)
+	assert.NoError(t, err)
+	eventType, aliasRegex, metric, err := parseAutomationEventFilter("ADD_ARTIFACT_ALIAS", filter)
+	assert.NoError(t, err)
+	assert.Equal(t, "artifact_alias_added", eventType)
+	assert.Equal(t, "staging|production", aliasRegex)
+	assert.Nil(t, metric)
+
+	eventType, _, _, err = parseAutomationEventFilter("LINK_MODEL", `{}`)
+	assert.NoError(t, err)
+	assert.Equal(t, "artifact_linked", eventType)
+
+	expected := &AutomationMetricFilter{Name: "loss", WindowSize: 5, AggOp: "MAX", CmpOp: "<=", Threshold: 0.1}
+	filter, err = buildAutomationEventFilter("run_metric_threshold", "", expected)
+	assert.NoError(t, err)
+	eventType, _, metric, err = parseAutomationEventFilter("RUN_METRIC", filter)
+	assert.NoError(t, err)
+	assert.Equal(t, "run_metric_threshold", eventType)
+	assert.Equal(t, expected, metric)
+
+	_, _, _, err = parseAutomationEventFilter("RUN_STATE", `{}`)
+	assert.Error(t, err)
+
+	_, _, _, err = parseAutomationEventFilter("RUN_METRIC", `{"run_filter": {}}`)
+	assert.Error(t, err)
+}
+
+func TestParseAutomationID(t *testing.T) {
+	entityName, projectName, triggerID, err := parseAutomationID("example-entity:wandb-registry-model:VHJpZ2dlcjox")
+	assert.NoError(t, err)
+	assert.Equal(t, "example-entity", entityName)
+	assert.Equal(t, "wandb-registry-model", projectName)
+	assert.Equal(t, "VHJpZ2dlcjox", triggerID)
+
+	_, _, _, err = parseAutomationID("example-entity:VHJpZ2dlcjox")
+	assert.Error(t, err)
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	assert.NoError(t, validateWebhookURL("https://example.com/hooks"))
+	assert.NoError(t, validateWebhookURL("http://localhost:8080"))
+	assert.Error(t, validateWebhookURL("ftp://example.com"))
+	assert.Error(t, validateWebhookURL("example.com/hooks"))
+	assert.Error(t, validateWebhookURL("https://"))
+}
+
+func TestSweepStateFromAPI(t *testing.T) {
+	for apiState, expected := range map[string]string{
+		"PENDING":  "running",
+		"RUNNING":  "running",
+		"PAUSED":   "paused",
+		"FINISHED": "finished",
+		"CANCELED": "finished",
+	} {
+		state, err := sweepStateFromAPI(apiState)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, state)
+	}
+
+	_, err := sweepStateFromAPI("UNKNOWN")
+	assert.Error(t, err)
+}
+
+func TestDecodeSweepParameterValue(t *testing.T) {
+	assert.Equal(t, 0.01, decodeSweepParameterValue("0.01"))
+	assert.Equal(t, true, decodeSweepParameterValue("true"))
+	assert.Equal(t, "adam", decodeSweepParameterValue("adam"))
+	assert.Equal(t, "adam", decodeSweepParameterValue(`"adam"`))
+}
+
+func TestValidateSweepConfigYAML(t *testing.T) {
+	assert.NoError(t, validateSweepConfigYAML("method: grid\nparameters:\n  lr:\n    values: [0.1, 0.01]\n"))
+	assert.Error(t, validateSweepConfigYAML("- grid"))
+	assert.Error(t, validateSweepConfigYAML(""))
+}
+
+func TestParseSweepID(t *testing.T) {
+	entityName, projectName, sweepName, err := parseSweepID(generateSweepID("example-entity", "example-project", "abc123"))
+	assert.NoError(t, err)
+	assert.Equal(t, "example-entity", entityName)
+	assert.Equal(t, "example-project", projectName)
+	assert.Equal(t, "abc123", sweepName)
+
+	_, _, _, err = parseSweepID("example-entity:abc123")
+	assert.Error(t, err)
+}
+
+func TestParseLaunchJobMetadata(t *testing.T) {
+	metadata := `{"source_type": "repo", "input_types": {"wb_type": "typedDict", "params": {"type_map": {"lr": {"wb_type": "number"}}}}}`
+	sourceType, inputSchema, err := parseLaunchJobMetadata(&metadata)
+	assert.NoError(t, err)
+	assert.Equal(t, "git", *sourceType)
+	assert.Equal(t, `{"params":{"type_map":{"lr":{"wb_type":"number"}}},"wb_type":"typedDict"}`, *inputSchema)
+
+	metadata = `{"source_type": "image", "input_schemas": {"type": "object"}, "input_types": {"wb_type": "typedDict"}}`
+	sourceType, inputSchema, err = parseLaunchJobMetadata(&metadata)
+	assert.NoError(t, err)
+	assert.Equal(t, "image", *sourceType)
+	assert.Equal(t, `{"type":"object"}`, *inputSchema)
+
+	sourceType, inputSchema, err = parseLaunchJobMetadata(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, sourceType)
+	assert.Nil(t, inputSchema)
+
+	metadata = `{"source_type": "notebook"}`
+	_, _, err = parseLaunchJobMetadata(&metadata)
+	assert.Error(t, err)
+}
+
+func TestResolveTemplateVariableValues(t *testing.T) {
+	templateVariables := []TemplateVariableWithName{
+		{Name: "gpus", Schema: `{"type": "integer", "minimum": 1, "maximum": 8}`},
+		{Name: "learning_rate", Schema: `{"type": "number"}`},
+		{Name: "size", Schema: `{"type": "string", "enum": ["small", "large"]}`},
+	}
+
+	resolved, err := resolveTemplateVariableValues(map[string]string{
+		"gpus":          "2",
+		"learning_rate": "0.01",
+		"size":          "large",
+	}, templateVariables)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"gpus": int64(2), "learning_rate": 0.01, "size": "large"}, resolved)
+
+	_, err = resolveTemplateVariableValues(map[string]string{"gpus": "16"}, templateVariables)
+	assert.Error(t, err)
+
+	_, err = resolveTemplateVariableValues(map[string]string{"gpus": "1.5"}, templateVariables)
+	assert.Error(t, err)
+
+	_, err = resolveTemplateVariableValues(map[string]string{"size": "medium"}, templateVariables)
+	assert.Error(t, err)
+
+	_, err = resolveTemplateVariableValues(map[string]string{"undefined": "value"}, templateVariables)
+	assert.Error(t, err)
+}
+
+func TestParseRunQueueItemID(t *testing.T) {
+	entityName, queueName, itemID, err := parseRunQueueItemID(generateRunQueueItemID("example-entity", "example-queue", "UnVuUXVldWVJdGVtOjE="))
+	assert.NoError(t, err)
+	assert.Equal(t, "example-entity", entityName)
+	assert.Equal(t, "example-queue", queueName)
+	assert.Equal(t, "UnVuUXVldWVJdGVtOjE=", itemID)
+
+	_, _, _, err = parseRunQueueItemID("example-entity:example-queue")
+	assert.Error(t, err)
+}
+
+func TestRunQueuePriorityName(t *testing.T) {
+	for name, priority := range runQueuePriorities {
+		result, err := runQueuePriorityName(priority)
+		assert.NoError(t, err)
+		assert.Equal(t, name, result)
+	}
+
+	_, err := runQueuePriorityName(7)
+	assert.Error(t, err)
+}
+
+func TestRunQueueAccessToModel(t *testing.T) {
+	model, diags := runQueueAccessToModel(nil, nil)
+	assert.False(t, diags.HasError())
+	assert.Nil(t, model)
+
+	model, diags = runQueueAccessToModel(&RunQueueAccess{Teams: []string{"ml-platform"}, AdminOnlyConfig: true}, nil)
+	assert.False(t, diags.HasError())
+	assert.True(t, model.Users.IsNull())
+	assert.Equal(t, 1, len(model.Teams.Elements()))
+	assert.True(t, model.AdminOnlyConfig.ValueBool())
+
+	// Restrictions removed outside of Terraform are reported as empty so the drift is detected.
+	model, diags = runQueueAccessToModel(&RunQueueAccess{}, model)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 0, len(model.Teams.Elements()))
+	assert.False(t, model.AdminOnlyConfig.ValueBool())
+}
+
+func TestStorageBucketProviderFromAPI(t *testing.T) {
+	for name, apiProvider := range storageBucketProviders {
+		provider, err := storageBucketProviderFromAPI(apiProvider)
+		assert.NoError(t, err)
+		assert.Equal(t, name, provider)
+	}
+
+	provider, err := storageBucketProviderFromAPI("Azure")
+	assert.NoError(t, err)
+	assert.Equal(t, "azure", provider)
+
+	_, err = storageBucketProviderFromAPI("CoreWeave")
+	assert.Error(t, err)
+}
+
+func TestStringPointerValueOrNull(t *testing.T) {
+	empty := ""
+	value := "us-east-1"
+	assert.True(t, stringPointerValueOrNull(nil).IsNull())
+	assert.True(t, stringPointerValueOrNull(&empty).IsNull())
+	assert.Equal(t, "us-east-1", stringPointerValueOrNull(&value).ValueString())
+}
+
+func TestCustomRoleBaseRoleFromAPI(t *testing.T) {
+	for name, apiRole := range customRoleBaseRoles {
+		role, err := customRoleBaseRoleFromAPI(apiRole)
+		assert.NoError(t, err)
+		assert.Equal(t, name, role)
+	}
+
+	role, err := customRoleBaseRoleFromAPI("viewer")
+	assert.NoError(t, err)
+	assert.Equal(t, "viewer", role)
+
+	_, err = customRoleBaseRoleFromAPI("ADMIN")
+	assert.Error(t, err)
+}
+
+func TestSCIMGroupMemberOperations(t *testing.T) {
+	operations := scimGroupMemberOperations([]string{"user-1", "user-2"}, []string{"user-2", "user-3"})
+	assert.Equal(t, []SCIMPatchOperation{
+		{Op: "add", Path: "members", Value: []SCIMGroupMember{{Value: "user-3"}}},
+		{Op: "remove", Path: `members[value eq "user-1"]`},
+	}, operations)
+
+	assert.Empty(t, scimGroupMemberOperations([]string{"user-1"}, []string{"user-1"}))
+}
+
+func TestWithOperationTimeout(t *testing.T) {
+	attributeTypes := map[string]attr.Type{"create": types.StringType}
+	configured := timeouts.Value{Object: types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+		"create": types.StringValue("5m"),
+	})}
+	unset := timeouts.Value{Object: types.ObjectNull(attributeTypes)}
+
+	for value, expected := range map[*timeouts.Value]time.Duration{
+		&configured: 5 * time.Minute,
+		&unset:      defaultOperationTimeout,
+	} {
+		var diags diag.Diagnostics
+		ctx, cancel := withOperationTimeout(context.Background(), value.Create, &diags)
+		deadline, ok := ctx.Deadline()
+		cancel()
+
+		assert.False(t, diags.HasError())
+		assert.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(expected), deadline, time.Minute)
+	}
+}
@@ -0,0 +1,406 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/machinebox/graphql"
+)
+
+const webhookIntegrationTypename = "GenericWebhookIntegration"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WebhookResource{}
+var _ resource.ResourceWithConfigure = &WebhookResource{}
+var _ resource.ResourceWithImportState = &WebhookResource{}
+var _ resource.ResourceWithValidateConfig = &WebhookResource{}
+var _ resource.ResourceWithModifyPlan = &WebhookResource{}
+
+func NewWebhookResource() resource.Resource {
+	return &WebhookResource{}
+}
+
+type WebhookResource struct {
+	client *GraphQLClientWithHeaders
+}
+
+type WebhookResourceModel struct {
+	Id             types.String   `tfsdk:"id"`
+	IntegrationId  types.String   `tfsdk:"integration_id"`
+	Name           types.String   `tfsdk:"name"`
+	EntityName     types.String   `tfsdk:"entity_name"`
+	Url            types.String   `tfsdk:"url"`
+	AccessTokenRef types.String   `tfsdk:"access_token_ref"`
+	SecretRef      types.String   `tfsdk:"secret_ref"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *WebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "wandb_webhook"
+}
+
+func (r *WebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Webhook integration resource. Webhooks are called by automations with webhook actions, and can authenticate using team secrets referenced by name. See [here](https://github.com/wandb/terraform-provider-wandb/blob/main/examples/resources/webhook/resource.tf) for an example",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the webhook. This is a composite ID of the entity name and the integration ID, separated by a ':'",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"integration_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the webhook integration, used as the integration_id of wandb_automation webhook actions.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the webhook.",
+			},
+			"entity_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the team that this webhook belongs to. Defaults to the provider's default_entity.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Required:    true,
+				Description: "The URL the webhook sends requests to. Must use the http or https scheme.",
+			},
+			"access_token_ref": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of a team secret whose value is sent as a bearer token in the Authorization header.",
+			},
+			"secret_ref": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of a team secret used to sign the request payload.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *WebhookResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data WebhookResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Url.IsNull() || data.Url.IsUnknown() {
+		return
+	}
+
+	if err := validateWebhookURL(data.Url.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("url"),
+			"Invalid webhook URL",
+			err.Error(),
+		)
+	}
+}
+
+func (r *WebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GraphQLClientWithHeaders)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WebhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		return
+	}
+
+	r.client.applyProviderDefaults(ctx, req, resp, "entity_name")
+}
+
+func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WebhookResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation CreateGenericWebhookIntegration(
+			$entityName: String!,
+			$name: String!,
+			$urlEndpoint: String!,
+			$accessTokenRef: String,
+			$secretRef: String,
+		) {
+			createGenericWebhookIntegration(input: {
+				entityName: $entityName,
+				name: $name,
+				urlEndpoint: $urlEndpoint,
+				accessTokenRef: $accessTokenRef,
+				secretRef: $secretRef,
+			}) {
+				integration {
+					... on GenericWebhookIntegration {
+						id
+					}
+				}
+			}
+		}
+	`)
+	gqlReq.Var("entityName", data.EntityName.ValueString())
+	gqlReq.Var("name", data.Name.ValueString())
+	gqlReq.Var("urlEndpoint", data.Url.ValueString())
+	gqlReq.Var("accessTokenRef", data.AccessTokenRef.ValueStringPointer())
+	gqlReq.Var("secretRef", data.SecretRef.ValueStringPointer())
+
+	var result struct {
+		CreateGenericWebhookIntegration struct {
+			Integration *struct {
+				ID string `json:"id"`
+			} `json:"integration"`
+		} `json:"createGenericWebhookIntegration"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating webhook",
+			"Could not create webhook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if result.CreateGenericWebhookIntegration.Integration == nil {
+		resp.Diagnostics.AddError(
+			"Failed to create webhook",
+			"The API did not return the created webhook.",
+		)
+		return
+	}
+
+	integrationID := result.CreateGenericWebhookIntegration.Integration.ID
+	data.IntegrationId = types.StringValue(integrationID)
+	data.Id = types.StringValue(generateCompositeID(data.EntityName.ValueString(), integrationID))
+
+	tflog.Trace(ctx, "created a webhook resource")
+
+	resp.Diagnostics.Append(r.client.recordInstance(ctx, resp.Private)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WebhookResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkInstance(ctx, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	entityName, integrationID, err := parseCompositeID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing composite ID", err.Error())
+		return
+	}
+
+	integration, err := readIntegrationHelper(ctx, entityName, integrationID, webhookIntegrationTypename, r.client)
+	if errors.Is(err, errIntegrationNotFound) {
+		tflog.Warn(ctx, "webhook no longer exists, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading webhook",
+			"Could not read webhook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.IntegrationId = types.StringValue(integration.ID)
+	data.EntityName = types.StringValue(entityName)
+	data.Name = types.StringPointerValue(integration.Name)
+	data.Url = types.StringPointerValue(integration.URLEndpoint)
+	data.AccessTokenRef = types.StringPointerValue(integration.AccessTokenRef)
+	data.SecretRef = types.StringPointerValue(integration.SecretRef)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WebhookResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gqlReq := graphql.NewRequest(`
+		mutation UpdateGenericWebhookIntegration(
+			$id: ID!,
+			$name: String!,
+			$urlEndpoint: String!,
+			$accessTokenRef: String,
+			$secretRef: String,
+		) {
+			updateGenericWebhookIntegration(input: {
+				id: $id,
+				name: $name,
+				urlEndpoint: $urlEndpoint,
+				accessTokenRef: $accessTokenRef,
+				secretRef: $secretRef,
+			}) {
+				integration {
+					... on GenericWebhookIntegration {
+						id
+					}
+				}
+			}
+		}
+	`)
+	gqlReq.Var("id", data.IntegrationId.ValueString())
+	gqlReq.Var("name", data.Name.ValueString())
+	gqlReq.Var("urlEndpoint", data.Url.ValueString())
+	gqlReq.Var("accessTokenRef", data.AccessTokenRef.ValueStringPointer())
+	gqlReq.Var("secretRef", data.SecretRef.ValueStringPointer())
+
+	var result struct {
+		UpdateGenericWebhookIntegration struct {
+			Integration *struct {
+				ID string `json:"id"`
+			} `json:"integration"`
+		} `json:"updateGenericWebhookIntegration"`
+	}
+
+	if err := r.client.Run(ctx, gqlReq, &result); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating webhook",
+			"Could not update webhook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if result.UpdateGenericWebhookIntegration.Integration == nil {
+		resp.Diagnostics.AddError(
+			"Failed to update webhook",
+			"The API did not confirm the update of the webhook.",
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WebhookResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withOperationTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	success, err := deleteIntegration(ctx, data.IntegrationId.ValueString(), r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting webhook",
+			"Could not delete webhook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !success {
+		resp.Diagnostics.AddError(
+			"Failed to delete webhook",
+			"The API did not confirm the deletion of the webhook.",
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a webhook resource")
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *WebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccWebhookResource(t *testing.T) {
+	resourceName := "wandb_webhook.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckWebhookResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookResourceConfig("https://example.com/hooks/first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "integration_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "terraform-example-webhook"),
+					resource.TestCheckResourceAttr(resourceName, "url", "https://example.com/hooks/first"),
+					resource.TestCheckResourceAttr(resourceName, "access_token_ref", "WEBHOOK_TOKEN"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWebhookResourceConfig("https://example.com/hooks/second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "url", "https://example.com/hooks/second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWebhookResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "wandb_webhook" {
+			continue
+		}
+
+		_, err := readIntegrationHelper(context.Background(), rs.Primary.Attributes["entity_name"], rs.Primary.Attributes["integration_id"], webhookIntegrationTypename, newGraphQLClient())
+		if err == nil {
+			return fmt.Errorf("webhook still exists: %s", rs.Primary.ID)
+		}
+		if !errors.Is(err, errIntegrationNotFound) {
+			return fmt.Errorf("checking that webhook %s was destroyed: %w", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccWebhookResourceConfig(url string) string {
+	return fmt.Sprintf(`
+resource "wandb_team_secret" "token" {
+  name        = "WEBHOOK_TOKEN"
+  entity_name = "terraform-acceptance-test"
+  value       = "example-token"
+}
+
+resource "wandb_webhook" "test" {
+  name             = "terraform-example-webhook"
+  entity_name      = "terraform-acceptance-test"
+  url              = %q
+  access_token_ref = wandb_team_secret.token.name
+}
+`, url)
+}
+
+func TestWebhookResourceRead_NotFound(t *testing.T) {
+	client, _ := newIntrospectionTestServer(t, nil, `{"data": {"entity": {"integrations": {"edges": [{"node": {"__typename": "SlackIntegration", "id": "integration-1"}}]}}}}`)
+	r := &WebhookResource{client: client}
+
+	resp := testRead(r, testResourceState(t, r, map[string]tftypes.Value{
+		"id":             tftypes.NewValue(tftypes.String, "terraform-acceptance-test:integration-1"),
+		"integration_id": tftypes.NewValue(tftypes.String, "integration-1"),
+		"entity_name":    tftypes.NewValue(tftypes.String, "terraform-acceptance-test"),
+		"name":           tftypes.NewValue(tftypes.String, "example"),
+		"url":            tftypes.NewValue(tftypes.String, "https://example.com/webhook"),
+	}))
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
 	"terraform-provider-wandb-launch/internal/provider"
 
@@ -32,6 +33,9 @@ var (
 	// https://goreleaser.com/cookbooks/using-main.version/
 )
 
+// tracingShutdownTimeout is the maximum time spent exporting the remaining spans on exit.
+const tracingShutdownTimeout = 2 * time.Second
+
 func main() {
 	var debug bool
 
@@ -45,6 +49,14 @@ func main() {
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
 
+	// Terraform gives the provider a few seconds to exit once it is done with it, which is
+	// enough to export the spans that are still batched.
+	ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	if shutdownErr := provider.ShutdownTracing(ctx); shutdownErr != nil {
+		log.Printf("failed to export traces: %s", shutdownErr)
+	}
+	cancel()
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}